@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+data "letscloud_account" "current" {}
+
+data "letscloud_plan" "worker" {
+  location  = "MIA1"
+  min_cores = 2
+}
+
+variable "worker_count" {
+  type    = number
+  default = 3
+}
+
+# Fail fast when the account cannot cover the first month of the rollout
+resource "letscloud_instance" "worker" {
+  count = var.worker_count
+
+  label         = "worker-${count.index + 1}"
+  plan_slug     = data.letscloud_plan.worker.slug
+  image_slug    = "ubuntu-24.04-x86_64"
+  location_slug = "MIA1"
+  hostname      = "worker-${count.index + 1}.example.com"
+  password      = "P@ssw0rd123!Secure"
+
+  lifecycle {
+    precondition {
+      condition     = data.letscloud_account.current.balance >= var.worker_count * data.letscloud_plan.worker.monthly_price
+      error_message = "The account balance does not cover a month of ${var.worker_count} workers."
+    }
+  }
+}
+
+output "account" {
+  value = "${data.letscloud_account.current.email}: ${data.letscloud_account.current.balance} ${data.letscloud_account.current.currency}"
+}
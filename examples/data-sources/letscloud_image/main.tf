@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Always pick the newest Ubuntu LTS image
+data "letscloud_image" "ubuntu" {
+  location     = "MIA1"
+  distribution = "Ubuntu"
+  name_regex   = "LTS"
+  most_recent  = true
+}
+
+resource "letscloud_instance" "web" {
+  label         = "web-server"
+  plan_slug     = "1vcpu-1gb-10ssd"
+  image_slug    = data.letscloud_image.ubuntu.slug
+  location_slug = "MIA1"
+  hostname      = "web.example.com"
+  password      = "P@ssw0rd123!Secure"
+}
+
+output "ubuntu_image" {
+  value = "${data.letscloud_image.ubuntu.name} (${data.letscloud_image.ubuntu.slug})"
+}
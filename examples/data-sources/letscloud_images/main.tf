@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# All Ubuntu LTS images available in Miami
+data "letscloud_images" "ubuntu_lts" {
+  location     = "MIA1"
+  distribution = "Ubuntu"
+  name_regex   = "LTS"
+}
+
+output "ubuntu_lts_images" {
+  value = [for image in data.letscloud_images.ubuntu_lts.images : "${image.slug} (${image.version})"]
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Look up an instance managed by another stack
+data "letscloud_instance" "database" {
+  label = "prod-database"
+}
+
+data "letscloud_instance" "bastion" {
+  hostname = "bastion.example.com"
+}
+
+output "database_address" {
+  value = data.letscloud_instance.database.ipv4
+}
+
+output "bastion" {
+  value = {
+    id    = data.letscloud_instance.bastion.id
+    state = data.letscloud_instance.bastion.state
+    ipv4  = data.letscloud_instance.bastion.ipv4
+    ipv6  = data.letscloud_instance.bastion.ipv6
+  }
+}
@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Running web servers in Miami
+data "letscloud_instances" "web" {
+  filter {
+    name   = "label"
+    values = ["^web-"]
+  }
+
+  filter {
+    name   = "location"
+    values = ["MIA1"]
+  }
+
+  filter {
+    name   = "state"
+    values = ["running"]
+  }
+
+  sort {
+    key = "hostname"
+  }
+}
+
+# Prometheus file_sd targets
+resource "local_file" "web_targets" {
+  filename = "${path.module}/web_targets.json"
+  content = jsonencode([{
+    targets = [for instance in data.letscloud_instances.web.instances : "${instance.ipv4}:9100"]
+    labels  = { job = "web" }
+  }])
+}
+
+# Ansible inventory
+output "ansible_inventory" {
+  value = join("\n", concat(["[web]"], [
+    for instance in data.letscloud_instances.web.instances :
+    "${instance.hostname} ansible_host=${instance.ipv4}"
+  ]))
+}
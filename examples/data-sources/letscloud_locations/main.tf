@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Fetch all locations
+data "letscloud_locations" "all" {}
+
+# Only keep locations that currently accept new instances
+locals {
+  available_locations = [
+    for location in data.letscloud_locations.all.locations :
+    location.slug if location.available
+  ]
+}
+
+output "available_locations" {
+  description = "Slugs of the locations that accept new instances"
+  value       = local.available_locations
+}
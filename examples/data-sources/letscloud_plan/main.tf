@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+variable "location" {
+  type    = string
+  default = "MIA1"
+}
+
+# Describe what the workload needs instead of hard-coding a plan slug
+data "letscloud_plan" "database" {
+  location      = var.location
+  min_cores     = 4
+  min_memory_mb = 8192
+  min_disk_gb   = 80
+}
+
+resource "letscloud_instance" "database" {
+  label         = "database"
+  plan_slug     = data.letscloud_plan.database.slug
+  image_slug    = "ubuntu-24.04-x86_64"
+  location_slug = var.location
+  hostname      = "db.example.com"
+  password      = "P@ssw0rd123!Secure"
+}
+
+output "database_plan" {
+  value = {
+    slug          = data.letscloud_plan.database.slug
+    monthly_price = data.letscloud_plan.database.monthly_price
+    currency      = data.letscloud_plan.database.currency
+  }
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Plans in Miami with at least 2 cores and 4GB of RAM, up to 40 per month
+data "letscloud_plans" "app" {
+  location          = "MIA1"
+  min_cores         = 2
+  min_memory_mb     = 4096
+  max_monthly_price = 40
+}
+
+# Plans are sorted by price, so the first one is the cheapest match
+resource "letscloud_instance" "app" {
+  label         = "app-server"
+  plan_slug     = data.letscloud_plans.app.plans[0].slug
+  image_slug    = "ubuntu-24.04-x86_64"
+  location_slug = "MIA1"
+  hostname      = "app.example.com"
+  password      = "P@ssw0rd123!Secure"
+}
+
+output "matching_plans" {
+  value = [for plan in data.letscloud_plans.app.plans : "${plan.slug} (${plan.monthly_price} ${plan.currency})"]
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Onboard an engineer with the keys from their GitHub profile
+data "letscloud_remote_ssh_keys" "alice" {
+  url = "https://github.com/alice.keys"
+
+  # Fail instead of granting access if the key list changes
+  pinned_fingerprints = [
+    "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A",
+  ]
+}
+
+resource "letscloud_ssh_key" "alice" {
+  for_each = { for i, key in data.letscloud_remote_ssh_keys.alice.public_keys : i => key }
+
+  label = "alice-${each.key}"
+  key   = each.value
+}
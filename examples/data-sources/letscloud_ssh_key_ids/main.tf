@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Resolve the team's keys with a single API call
+data "letscloud_ssh_key_ids" "team" {
+  labels = ["alice-laptop", "bob-laptop", "ci-deploy"]
+}
+
+resource "letscloud_instance" "web" {
+  label         = "web-server"
+  plan_slug     = "1vcpu-1gb-10ssd"
+  image_slug    = "ubuntu-24.04-x86_64"
+  location_slug = "MIA1"
+  hostname      = "web-server.example.com"
+  ssh_keys      = data.letscloud_ssh_key_ids.team.ids
+  password      = "P@ssw0rd123!Secure"
+}
+
+# Skip keys that have not been uploaded yet instead of failing
+data "letscloud_ssh_key_ids" "optional" {
+  labels = ["oncall-primary", "oncall-secondary"]
+  strict = false
+}
+
+output "missing_oncall_keys" {
+  value = data.letscloud_ssh_key_ids.optional.missing_labels
+}
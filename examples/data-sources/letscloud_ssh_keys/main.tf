@@ -31,31 +31,39 @@ resource "letscloud_instance" "web_servers" {
   password = "P@ssw0rd123!Secure"
 }
 
-# Filter SSH keys by label pattern
-locals {
-  production_keys = [
-    for key in data.letscloud_ssh_keys.all.ssh_keys :
-    key if can(regex("prod", key.label))
-  ]
+# Filter SSH keys on the API side of the provider
+data "letscloud_ssh_keys" "production" {
+  label_regex = "prod"
+}
 
-  development_keys = [
-    for key in data.letscloud_ssh_keys.all.ssh_keys :
-    key if can(regex("dev", key.label))
-  ]
+data "letscloud_ssh_keys" "team" {
+  label_prefix = "team-"
+}
+
+# Check whether a given key is present in the account
+data "letscloud_ssh_keys" "alice" {
+  fingerprint = "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"
 }
 
 # Output filtered keys
 output "production_ssh_keys" {
   description = "SSH keys with 'prod' in the label"
-  value       = local.production_keys
+  value       = data.letscloud_ssh_keys.production.ssh_keys[*].label
 }
 
-output "development_ssh_keys" {
-  description = "SSH keys with 'dev' in the label"
-  value       = local.development_keys
+output "weak_team_keys" {
+  description = "Team keys using RSA with fewer than 3072 bits"
+  value = [
+    for key in data.letscloud_ssh_keys.team.ssh_keys :
+    key.label if key.key_type == "ssh-rsa" && coalesce(key.bits, 0) < 3072
+  ]
+}
+
+output "alice_key_present" {
+  value = length(data.letscloud_ssh_keys.alice.ssh_keys) > 0
 }
 
 output "total_ssh_keys_count" {
   description = "Total number of SSH keys in the account"
   value       = length(data.letscloud_ssh_keys.all.ssh_keys)
-} 
\ No newline at end of file
+}
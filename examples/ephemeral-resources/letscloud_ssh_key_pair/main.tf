@@ -0,0 +1,40 @@
+terraform {
+  # Ephemeral resources require Terraform 1.10 or later
+  required_version = ">= 1.10"
+
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Generate a throwaway key for this run. Neither half is written to state,
+# and the registered SSH key is deleted again when the run finishes.
+ephemeral "letscloud_ssh_key_pair" "ci" {
+  algorithm = "ed25519"
+  comment   = "ci@example.com"
+  register  = true
+}
+
+# Use the private key to connect to an existing host during the run
+resource "terraform_data" "deploy" {
+  triggers_replace = [timestamp()]
+
+  connection {
+    type        = "ssh"
+    host        = "203.0.113.10"
+    user        = "root"
+    private_key = ephemeral.letscloud_ssh_key_pair.ci.private_key_openssh
+  }
+
+  provisioner "remote-exec" {
+    inline = ["systemctl restart app"]
+  }
+}
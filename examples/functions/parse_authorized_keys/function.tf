@@ -0,0 +1,12 @@
+# Manage one SSH key per line of a checked-in authorized_keys file, labelled
+# by the key comment. Malformed lines fail the plan instead of being skipped
+locals {
+  team_keys = provider::letscloud::parse_authorized_keys(file("${path.module}/team_authorized_keys"), true)
+}
+
+resource "letscloud_ssh_key" "team" {
+  for_each = { for key in local.team_keys : key.comment => key }
+
+  label = each.key
+  key   = each.value.key
+}
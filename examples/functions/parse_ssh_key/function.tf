@@ -0,0 +1,14 @@
+locals {
+  deploy_key = provider::letscloud::parse_ssh_key(file("~/.ssh/deploy.pub"))
+}
+
+check "deploy_key_strength" {
+  assert {
+    condition     = local.deploy_key.type == "ssh-ed25519" || local.deploy_key.bits >= 3072
+    error_message = "Deploy keys must be Ed25519 or at least 3072 bits, got ${local.deploy_key.type} with ${local.deploy_key.bits} bits."
+  }
+}
+
+output "deploy_key_owner" {
+  value = local.deploy_key.comment
+}
@@ -0,0 +1,16 @@
+# Fail the plan if the deploy key is not the one that was reviewed
+resource "letscloud_ssh_key" "deploy" {
+  label = "deploy"
+  key   = file("~/.ssh/deploy.pub")
+
+  lifecycle {
+    precondition {
+      condition     = provider::letscloud::ssh_key_fingerprint(file("~/.ssh/deploy.pub"), "sha256") == "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"
+      error_message = "The deploy key does not have the reviewed fingerprint."
+    }
+  }
+}
+
+output "deploy_key_md5" {
+  value = provider::letscloud::ssh_key_fingerprint(letscloud_ssh_key.deploy.key, "md5")
+}
@@ -35,6 +35,10 @@ resource "letscloud_instance" "production" {
     letscloud_ssh_key.developer.id
   ]
   password = "P@ssw0rd123!Secure" # Must meet password requirements
+
+  # Refuse destroy and replacement until explicitly disabled
+  deletion_protection = true
+
   depends_on = [
     letscloud_ssh_key.admin,
     letscloud_ssh_key.developer
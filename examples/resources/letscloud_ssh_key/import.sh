@@ -0,0 +1,8 @@
+# SSH keys can be imported by ID
+terraform import letscloud_ssh_key.main <ssh-key-id>
+
+# by label
+terraform import letscloud_ssh_key.main label:deploy-bot
+
+# or by SHA256 or MD5 fingerprint, which fails if several keys share it
+terraform import letscloud_ssh_key.main fingerprint:SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A
@@ -18,6 +18,17 @@ resource "letscloud_ssh_key" "main" {
   key   = file("~/.ssh/id_rsa.pub")
 }
 
+# Rotate a deploy key without downtime: the new key gets a fresh unique label
+# and is created before the old one is deleted
+resource "letscloud_ssh_key" "deploy" {
+  label_prefix = "deploy-"
+  key          = file("~/.ssh/deploy.pub")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
 # Output the SSH key ID for reference
 output "ssh_key_id" {
   value = letscloud_ssh_key.main.id
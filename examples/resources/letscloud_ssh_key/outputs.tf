@@ -10,10 +10,10 @@ output "ssh_key_label" {
   value       = letscloud_ssh_key.main.label
 }
 
-# Output the SSH key fingerprint (if available)
+# Output the SSH key fingerprint, known at plan time
 output "ssh_key_fingerprint" {
-  description = "The fingerprint of the SSH key (if available)"
-  value       = letscloud_ssh_key.main.id
+  description = "The SHA256 fingerprint of the SSH key"
+  value       = letscloud_ssh_key.main.fingerprint_sha256
 }
 
 output "admin_key_id" {
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    letscloud = {
+      source  = "letscloud-community/letscloud"
+      version = "1.0.1"
+    }
+  }
+}
+
+provider "letscloud" {
+  # API token can be set via LETSCLOUD_API_TOKEN environment variable
+  api_token = "your-api-token"
+}
+
+# Manage the team's authorized_keys file as one resource. Each key is labelled
+# with its comment, so adding or removing a line only touches that key
+resource "letscloud_ssh_key_set" "team" {
+  authorized_keys = file("${path.module}/team_authorized_keys")
+}
+
+# Or list the keys with explicit labels
+resource "letscloud_ssh_key_set" "deploy" {
+  keys = {
+    "deploy-ci"      = file("~/.ssh/ci.pub")
+    "deploy-release" = file("~/.ssh/release.pub")
+  }
+}
+
+# Give every key of the team access to an instance
+resource "letscloud_instance" "bastion" {
+  label         = "bastion"
+  plan_slug     = "1vcpu-1gb-10ssd"
+  image_slug    = "ubuntu-24.04-x86_64"
+  location_slug = "MIA1"
+  hostname      = "bastion.example.com"
+  ssh_keys      = values(letscloud_ssh_key_set.team.ssh_key_ids)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+// AccountDataSource defines the data source implementation.
+type AccountDataSource struct {
+	client LetsCloudClient
+}
+
+// AccountDataSourceModel describes the data source data model.
+type AccountDataSourceModel struct {
+	Name        types.String  `tfsdk:"name"`
+	CompanyName types.String  `tfsdk:"company_name"`
+	Email       types.String  `tfsdk:"email"`
+	Balance     types.Float64 `tfsdk:"balance"`
+	Currency    types.String  `tfsdk:"currency"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches information about the LetsCloud account the API token belongs to. " +
+			"The LetsCloud API does not expose account quotas, such as instance or SSH key limits, so they are not available here.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the account holder.",
+				Computed:            true,
+			},
+			"company_name": schema.StringAttribute{
+				MarkdownDescription: "The company name of the account.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the account.",
+				Computed:            true,
+			},
+			"balance": schema.Float64Attribute{
+				MarkdownDescription: "The current balance of the account.",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The currency code of `balance`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := d.client.Profile()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account profile, got error: %s", err))
+		return
+	}
+
+	// The API returns the balance as a formatted string
+	balance, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(profile.Balance), ",", ""), 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse account balance %q, got error: %s", profile.Balance, err))
+		return
+	}
+
+	// Map response body to model
+	data.Name = types.StringValue(profile.Name)
+	data.CompanyName = types.StringValue(profile.CompanyName)
+	data.Email = types.StringValue(profile.Email)
+	data.Balance = types.Float64Value(balance)
+	data.Currency = types.StringValue(profile.Currency)
+
+	tflog.Info(ctx, "Account data source read successfully", map[string]interface{}{
+		"email": profile.Email,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
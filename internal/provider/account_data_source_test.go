@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+func TestAccAccountDataSource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+data "letscloud_account" "current" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_account.current", "email", "mock@example.com"),
+					resource.TestCheckResourceAttr("data.letscloud_account.current", "name", "Mock User"),
+					resource.TestCheckResourceAttr("data.letscloud_account.current", "balance", "100"),
+					resource.TestCheckResourceAttr("data.letscloud_account.current", "currency", "USD"),
+				),
+			},
+		},
+	})
+}
+
+// balanceClient returns the mock profile with its balance replaced.
+type balanceClient struct {
+	LetsCloudClient
+	balance string
+}
+
+func (c *balanceClient) Profile() (*domains.Profile, error) {
+	profile, err := c.LetsCloudClient.Profile()
+	if err != nil {
+		return nil, err
+	}
+	profile.Balance = c.balance
+	return profile, nil
+}
+
+func TestAccountDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		client          LetsCloudClient
+		expectedBalance float64
+		expectError     bool
+	}{
+		"mock profile": {
+			client:          NewLetsCloudClientMock(),
+			expectedBalance: 100,
+		},
+		"thousands separator": {
+			client:          &balanceClient{LetsCloudClient: NewLetsCloudClientMock(), balance: " 1,234.50 "},
+			expectedBalance: 1234.5,
+		},
+		"invalid balance": {
+			client:      &balanceClient{LetsCloudClient: NewLetsCloudClientMock(), balance: "n/a"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := &AccountDataSource{client: test.client}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name":         tftypes.NewValue(tftypes.String, nil),
+					"company_name": tftypes.NewValue(tftypes.String, nil),
+					"email":        tftypes.NewValue(tftypes.String, nil),
+					"balance":      tftypes.NewValue(tftypes.Number, nil),
+					"currency":     tftypes.NewValue(tftypes.String, nil),
+				})},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if test.expectError {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data AccountDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if data.Balance.ValueFloat64() != test.expectedBalance {
+				t.Errorf("expected balance %v, got %v", test.expectedBalance, data.Balance.ValueFloat64())
+			}
+			if data.Currency.ValueString() != "USD" {
+				t.Errorf("expected currency USD, got %s", data.Currency.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImageDataSource{}
+
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+// ImageDataSource defines the data source implementation.
+type ImageDataSource struct {
+	client client.LetsCloudClient
+}
+
+// ImageDataSourceModel describes the data source data model.
+type ImageDataSourceModel struct {
+	Location     types.String `tfsdk:"location"`
+	Distribution types.String `tfsdk:"distribution"`
+	Version      types.String `tfsdk:"version"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	MostRecent   types.Bool   `tfsdk:"most_recent"`
+	Slug         types.String `tfsdk:"slug"`
+	Name         types.String `tfsdk:"name"`
+}
+
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := imageFilterAttributes()
+	attributes["most_recent"] = schema.BoolAttribute{
+		MarkdownDescription: "When several images match, select the one with the newest version instead of failing.",
+		Optional:            true,
+	}
+
+	// The filter attributes double as results for the selected image
+	for name, attribute := range imageAttributes() {
+		if _, ok := attributes[name]; ok {
+			continue
+		}
+		attributes[name] = attribute
+	}
+	attributes["distribution"] = schema.StringAttribute{
+		MarkdownDescription: "Only match images of this distribution, compared case-insensitively. Set to the distribution of the selected image when omitted.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["version"] = schema.StringAttribute{
+		MarkdownDescription: "Only match images of this version. A partial version such as `22` matches `22.04`. Set to the version of the selected image when omitted.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Selects a single image in a location by distribution, version and name. " +
+			"Reading fails when no image matches, or when several match and `most_recent` is not set.",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := newImageFilter(data.Distribution.ValueString(), data.Version.ValueString(), data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	images, err := d.client.LocationImages(data.Location.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list images, got error: %s", err))
+		return
+	}
+
+	matches := matchingImages(images, filter)
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"No Matching Image",
+			fmt.Sprintf("No image in location '%s' matches the given criteria.", data.Location.ValueString()),
+		)
+		return
+	}
+
+	selected := matches[0]
+	if len(matches) > 1 {
+		if !data.MostRecent.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Multiple Matching Images",
+				fmt.Sprintf("%d images in location '%s' match the given criteria. "+
+					"Use more specific criteria, or set most_recent = true to select the newest one.", len(matches), data.Location.ValueString()),
+			)
+			return
+		}
+
+		// Matches are grouped by distribution, so look for the newest version across all groups
+		for _, image := range matches[1:] {
+			if compareVersions(imageVersion(image), imageVersion(selected)) > 0 {
+				selected = image
+			}
+		}
+	}
+
+	// Map response body to model
+	// Configured filters are kept as is, only unset ones are filled in
+	image := newImageModel(selected)
+	data.Slug = image.Slug
+	data.Name = image.Name
+	if data.Distribution.IsNull() {
+		data.Distribution = image.Distribution
+	}
+	if data.Version.IsNull() {
+		data.Version = image.Version
+	}
+
+	tflog.Info(ctx, "Image data source read successfully", map[string]interface{}{
+		"location": data.Location.ValueString(),
+		"slug":     data.Slug.ValueString(),
+		"matching": len(matches),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
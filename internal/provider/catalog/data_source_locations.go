@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocationsDataSource{}
+
+func NewLocationsDataSource() datasource.DataSource {
+	return &LocationsDataSource{}
+}
+
+// LocationsDataSource defines the data source implementation.
+type LocationsDataSource struct {
+	client client.LetsCloudClient
+}
+
+// LocationsDataSourceModel describes the data source data model.
+type LocationsDataSourceModel struct {
+	Locations []LocationModel `tfsdk:"locations"`
+}
+
+// LocationModel describes a single location.
+type LocationModel struct {
+	Slug      types.String `tfsdk:"slug"`
+	City      types.String `tfsdk:"city"`
+	Country   types.String `tfsdk:"country"`
+	Available types.Bool   `tfsdk:"available"`
+}
+
+func (d *LocationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locations"
+}
+
+func (d *LocationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all LetsCloud locations where instances can be created. The LetsCloud API does not report the features of a location, so they are not available here.",
+
+		Attributes: map[string]schema.Attribute{
+			"locations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of locations, sorted by slug.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The slug of the location, as used by `location_slug`.",
+							Computed:            true,
+						},
+						"city": schema.StringAttribute{
+							MarkdownDescription: "The city the location is in.",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "The country the location is in.",
+							Computed:            true,
+						},
+						"available": schema.BoolAttribute{
+							MarkdownDescription: "Whether new instances can currently be created in the location.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LocationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LocationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locations, err := d.client.Locations()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list locations, got error: %s", err))
+		return
+	}
+
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].Slug < locations[j].Slug
+	})
+
+	// Map response to model
+	data.Locations = make([]LocationModel, len(locations))
+	for i, location := range locations {
+		data.Locations[i] = LocationModel{
+			Slug:      types.StringValue(location.Slug),
+			City:      types.StringValue(location.City),
+			Country:   types.StringValue(location.Country),
+			Available: types.BoolValue(location.Available),
+		}
+	}
+
+	tflog.Info(ctx, "Locations data source read successfully", map[string]interface{}{
+		"count": len(locations),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
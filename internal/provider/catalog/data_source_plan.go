@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlanDataSource{}
+
+func NewPlanDataSource() datasource.DataSource {
+	return &PlanDataSource{}
+}
+
+// PlanDataSource defines the data source implementation.
+type PlanDataSource struct {
+	client client.LetsCloudClient
+}
+
+// PlanDataSourceModel describes the data source data model.
+type PlanDataSourceModel struct {
+	Location     types.String  `tfsdk:"location"`
+	MinCores     types.Int64   `tfsdk:"min_cores"`
+	MinMemoryMB  types.Int64   `tfsdk:"min_memory_mb"`
+	MinDiskGB    types.Int64   `tfsdk:"min_disk_gb"`
+	Slug         types.String  `tfsdk:"slug"`
+	Shortcode    types.String  `tfsdk:"shortcode"`
+	Cores        types.Int64   `tfsdk:"cores"`
+	MemoryMB     types.Int64   `tfsdk:"memory_mb"`
+	DiskGB       types.Int64   `tfsdk:"disk_gb"`
+	Bandwidth    types.Int64   `tfsdk:"bandwidth"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+	Currency     types.String  `tfsdk:"currency"`
+}
+
+func (d *PlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plan"
+}
+
+func (d *PlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := planAttributes()
+	attributes["location"] = schema.StringAttribute{
+		MarkdownDescription: "The slug of the location to pick a plan in.",
+		Required:            true,
+	}
+	attributes["min_cores"] = schema.Int64Attribute{
+		MarkdownDescription: "The minimum number of CPU cores the plan must have.",
+		Optional:            true,
+	}
+	attributes["min_memory_mb"] = schema.Int64Attribute{
+		MarkdownDescription: "The minimum amount of memory the plan must have, in MB.",
+		Optional:            true,
+	}
+	attributes["min_disk_gb"] = schema.Int64Attribute{
+		MarkdownDescription: "The minimum disk size the plan must have, in GB.",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Selects the cheapest plan in a location that meets the given CPU, memory and disk requirements. " +
+			"When several plans share the lowest price, the one with the most cores, then memory, then disk is chosen, " +
+			"falling back to the first slug in alphabetical order. Reading fails when no plan matches.",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *PlanDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlanDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plans, err := d.client.LocationPlans(data.Location.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list plans, got error: %s", err))
+		return
+	}
+
+	matches, err := matchingPlans(plans, planRequirements{
+		MinCores:    data.MinCores.ValueInt64(),
+		MinMemoryMB: data.MinMemoryMB.ValueInt64(),
+		MinDiskGB:   data.MinDiskGB.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plans, got error: %s", err))
+		return
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"No Matching Plan",
+			fmt.Sprintf("No plan in location '%s' has at least %d cores, %d MB of memory and %d GB of disk.",
+				data.Location.ValueString(), data.MinCores.ValueInt64(), data.MinMemoryMB.ValueInt64(), data.MinDiskGB.ValueInt64()),
+		)
+		return
+	}
+
+	// Map response body to model
+	plan := newPlanModel(matches[0])
+	data.Slug = plan.Slug
+	data.Shortcode = plan.Shortcode
+	data.Cores = plan.Cores
+	data.MemoryMB = plan.MemoryMB
+	data.DiskGB = plan.DiskGB
+	data.Bandwidth = plan.Bandwidth
+	data.MonthlyPrice = plan.MonthlyPrice
+	data.Currency = plan.Currency
+
+	tflog.Info(ctx, "Plan data source read successfully", map[string]interface{}{
+		"location": data.Location.ValueString(),
+		"slug":     data.Slug.ValueString(),
+		"matching": len(matches),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PlansDataSource{}
+
+func NewPlansDataSource() datasource.DataSource {
+	return &PlansDataSource{}
+}
+
+// PlansDataSource defines the data source implementation.
+type PlansDataSource struct {
+	client client.LetsCloudClient
+}
+
+// PlansDataSourceModel describes the data source data model.
+type PlansDataSourceModel struct {
+	Location        types.String  `tfsdk:"location"`
+	MinCores        types.Int64   `tfsdk:"min_cores"`
+	MinMemoryMB     types.Int64   `tfsdk:"min_memory_mb"`
+	MinDiskGB       types.Int64   `tfsdk:"min_disk_gb"`
+	MaxMonthlyPrice types.Float64 `tfsdk:"max_monthly_price"`
+	Plans           []PlanModel   `tfsdk:"plans"`
+}
+
+func (d *PlansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plans"
+}
+
+func (d *PlansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the plans available in a location, optionally filtered by specs and price.",
+
+		Attributes: map[string]schema.Attribute{
+			"location": schema.StringAttribute{
+				MarkdownDescription: "The slug of the location to list plans for.",
+				Required:            true,
+			},
+			"min_cores": schema.Int64Attribute{
+				MarkdownDescription: "Only return plans with at least this many CPU cores.",
+				Optional:            true,
+			},
+			"min_memory_mb": schema.Int64Attribute{
+				MarkdownDescription: "Only return plans with at least this much memory, in MB.",
+				Optional:            true,
+			},
+			"min_disk_gb": schema.Int64Attribute{
+				MarkdownDescription: "Only return plans with at least this much disk, in GB.",
+				Optional:            true,
+			},
+			"max_monthly_price": schema.Float64Attribute{
+				MarkdownDescription: "Only return plans costing at most this much per month.",
+				Optional:            true,
+			},
+			"plans": schema.ListNestedAttribute{
+				MarkdownDescription: "List of matching plans, cheapest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: planAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *PlansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plans, err := d.client.LocationPlans(data.Location.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list plans, got error: %s", err))
+		return
+	}
+
+	requirements := planRequirements{
+		MinCores:    data.MinCores.ValueInt64(),
+		MinMemoryMB: data.MinMemoryMB.ValueInt64(),
+		MinDiskGB:   data.MinDiskGB.ValueInt64(),
+	}
+	if !data.MaxMonthlyPrice.IsNull() {
+		maxPrice := data.MaxMonthlyPrice.ValueFloat64()
+		requirements.MaxMonthlyPrice = &maxPrice
+	}
+
+	matches, err := matchingPlans(plans, requirements)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read plans, got error: %s", err))
+		return
+	}
+
+	// Map response to model
+	data.Plans = make([]PlanModel, len(matches))
+	for i, plan := range matches {
+		data.Plans[i] = newPlanModel(plan)
+	}
+
+	tflog.Info(ctx, "Plans data source read successfully", map[string]interface{}{
+		"location": data.Location.ValueString(),
+		"total":    len(plans),
+		"matching": len(matches),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider"
+)
+
+const (
+	providerConfig = `
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+`
+)
+
+var (
+	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"letscloud": providerserver.NewProtocol6WithError(provider.New("test")()),
+	}
+)
+
+func TestAccLocationsDataSource(t *testing.T) {
+	provider.MockLetsCloudClient = provider.NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "letscloud_locations" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_locations.all", "locations.#", "3"),
+					resource.TestCheckResourceAttr("data.letscloud_locations.all", "locations.0.slug", "br-sp-1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.letscloud_locations.all", "locations.*", map[string]string{
+						"slug":      "us-east-1",
+						"city":      "Miami",
+						"country":   "United States",
+						"available": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlansDataSource(t *testing.T) {
+	provider.MockLetsCloudClient = provider.NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "letscloud_plans" "all" {
+  location = "us-east-1"
+}
+
+data "letscloud_plans" "filtered" {
+  location          = "us-east-1"
+  min_cores         = 2
+  min_memory_mb     = 2048
+  max_monthly_price = 30
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_plans.all", "plans.#", "4"),
+					resource.TestCheckResourceAttr("data.letscloud_plans.all", "plans.0.slug", "plan-1"),
+					resource.TestCheckResourceAttr("data.letscloud_plans.all", "plans.0.monthly_price", "10"),
+					resource.TestCheckResourceAttr("data.letscloud_plans.all", "plans.3.slug", "plan-4"),
+
+					resource.TestCheckResourceAttr("data.letscloud_plans.filtered", "plans.#", "2"),
+					resource.TestCheckResourceAttr("data.letscloud_plans.filtered", "plans.0.slug", "plan-2-storage"),
+					resource.TestCheckResourceAttr("data.letscloud_plans.filtered", "plans.1.slug", "plan-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlanDataSource(t *testing.T) {
+	provider.MockLetsCloudClient = provider.NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "letscloud_plan" "cheapest" {
+  location = "us-east-1"
+}
+
+data "letscloud_plan" "two_cores" {
+  location      = "us-east-1"
+  min_cores     = 2
+  min_memory_mb = 2048
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_plan.cheapest", "slug", "plan-1"),
+					resource.TestCheckResourceAttr("data.letscloud_plan.cheapest", "cores", "1"),
+
+					// plan-2 and plan-2-storage cost the same, the one with more disk wins
+					resource.TestCheckResourceAttr("data.letscloud_plan.two_cores", "slug", "plan-2-storage"),
+					resource.TestCheckResourceAttr("data.letscloud_plan.two_cores", "disk_gb", "50"),
+					resource.TestCheckResourceAttr("data.letscloud_plan.two_cores", "monthly_price", "20"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "letscloud_plan" "too_big" {
+  location  = "us-east-1"
+  min_cores = 64
+}
+`,
+				ExpectError: regexp.MustCompile(`No plan in location 'us-east-1' has at least 64 cores`),
+			},
+		},
+	})
+}
+
+func TestAccImagesDataSource(t *testing.T) {
+	provider.MockLetsCloudClient = provider.NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "letscloud_images" "all" {
+  location = "us-east-1"
+}
+
+data "letscloud_images" "ubuntu" {
+  location     = "us-east-1"
+  distribution = "ubuntu"
+  name_regex   = "LTS$"
+}
+
+data "letscloud_images" "debian_12" {
+  location = "us-east-1"
+  version  = "12"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_images.all", "images.#", "5"),
+					resource.TestCheckResourceAttr("data.letscloud_images.all", "images.0.slug", "debian-12-x86_64"),
+
+					resource.TestCheckResourceAttr("data.letscloud_images.ubuntu", "images.#", "3"),
+					resource.TestCheckResourceAttr("data.letscloud_images.ubuntu", "images.0.slug", "ubuntu-24.04-x86_64"),
+					resource.TestCheckResourceAttr("data.letscloud_images.ubuntu", "images.0.version", "24.04"),
+
+					resource.TestCheckResourceAttr("data.letscloud_images.debian_12", "images.#", "1"),
+					resource.TestCheckResourceAttr("data.letscloud_images.debian_12", "images.0.distribution", "Debian"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageDataSource(t *testing.T) {
+	provider.MockLetsCloudClient = provider.NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "letscloud_image" "latest_ubuntu" {
+  location     = "us-east-1"
+  distribution = "Ubuntu"
+  most_recent  = true
+}
+
+data "letscloud_image" "ubuntu_22" {
+  location     = "us-east-1"
+  distribution = "Ubuntu"
+  version      = "22.04"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_image.latest_ubuntu", "slug", "ubuntu-24.04-x86_64"),
+					resource.TestCheckResourceAttr("data.letscloud_image.latest_ubuntu", "version", "24.04"),
+					resource.TestCheckResourceAttr("data.letscloud_image.ubuntu_22", "slug", "ubuntu-22.04-x86_64"),
+					resource.TestCheckResourceAttr("data.letscloud_image.ubuntu_22", "name", "Ubuntu 22.04 LTS"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "letscloud_image" "ambiguous" {
+  location     = "us-east-1"
+  distribution = "Ubuntu"
+}
+`,
+				ExpectError: regexp.MustCompile(`3 images in location 'us-east-1' match the given criteria`),
+			},
+			{
+				Config: providerConfig + `
+data "letscloud_image" "missing" {
+  location     = "us-east-1"
+  distribution = "Fedora"
+}
+`,
+				ExpectError: regexp.MustCompile(`No image in location 'us-east-1' matches the given criteria`),
+			},
+		},
+	})
+}
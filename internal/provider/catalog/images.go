@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+// ImageModel describes a single image.
+type ImageModel struct {
+	Slug         types.String `tfsdk:"slug"`
+	Distribution types.String `tfsdk:"distribution"`
+	Version      types.String `tfsdk:"version"`
+	Name         types.String `tfsdk:"name"`
+}
+
+// imageAttributes returns the computed attributes describing an image. They
+// are shared by the images list elements and the single image data source.
+func imageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The slug of the image, as used by `image_slug`.",
+			Computed:            true,
+		},
+		"distribution": schema.StringAttribute{
+			MarkdownDescription: "The distribution of the image, e.g. `Ubuntu`.",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The distribution version, derived from the image name or slug, e.g. `24.04`. Empty when it cannot be determined.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The full operating system name of the image.",
+			Computed:            true,
+		},
+	}
+}
+
+// imageFilterAttributes returns the optional attributes used to filter images.
+func imageFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"location": schema.StringAttribute{
+			MarkdownDescription: "The slug of the location to list images for.",
+			Required:            true,
+		},
+		"distribution": schema.StringAttribute{
+			MarkdownDescription: "Only match images of this distribution, compared case-insensitively.",
+			Optional:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "Only match images of this version. A partial version such as `22` matches `22.04`.",
+			Optional:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Only match images whose operating system name matches this regular expression.",
+			Optional:            true,
+		},
+	}
+}
+
+// imageFilter holds the criteria an image must meet. Empty fields match any image.
+type imageFilter struct {
+	Distribution string
+	Version      string
+	NameRegex    *regexp.Regexp
+}
+
+// newImageFilter builds an image filter, compiling nameRegex when set.
+func newImageFilter(distribution, version, nameRegex string) (imageFilter, error) {
+	filter := imageFilter{
+		Distribution: distribution,
+		Version:      version,
+	}
+
+	if nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return filter, fmt.Errorf("invalid name_regex: %w", err)
+		}
+		filter.NameRegex = re
+	}
+
+	return filter, nil
+}
+
+// versionPattern matches the first version number in an image name or slug,
+// e.g. "22.04" in "Ubuntu 22.04 LTS" or "22-04" in "ubuntu-22-04".
+var versionPattern = regexp.MustCompile(`\d+(?:[.-]\d+)*`)
+
+// imageVersion derives the version of an image from its OS name, falling
+// back to its slug. Dashes are normalized to dots.
+func imageVersion(image domains.Image) string {
+	for _, source := range []string{image.OS, image.Slug} {
+		if match := versionPattern.FindString(source); match != "" {
+			return strings.ReplaceAll(match, "-", ".")
+		}
+	}
+	return ""
+}
+
+// compareVersions compares two dotted versions numerically, returning -1, 0 or 1.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+	}
+	return 0
+}
+
+// matches reports whether the image meets the filter.
+func (f imageFilter) matches(image domains.Image) bool {
+	if f.Distribution != "" && !strings.EqualFold(image.Distro, f.Distribution) {
+		return false
+	}
+
+	if f.Version != "" {
+		version := imageVersion(image)
+		if version != f.Version && !strings.HasPrefix(version, f.Version+".") {
+			return false
+		}
+	}
+
+	if f.NameRegex != nil && !f.NameRegex.MatchString(image.OS) {
+		return false
+	}
+
+	return true
+}
+
+// matchingImages returns the images meeting the filter, grouped by
+// distribution with the newest version first.
+func matchingImages(images []domains.Image, filter imageFilter) []domains.Image {
+	matches := make([]domains.Image, 0, len(images))
+	for _, image := range images {
+		if filter.matches(image) {
+			matches = append(matches, image)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if !strings.EqualFold(a.Distro, b.Distro) {
+			return strings.ToLower(a.Distro) < strings.ToLower(b.Distro)
+		}
+		if cmp := compareVersions(imageVersion(a), imageVersion(b)); cmp != 0 {
+			return cmp > 0
+		}
+		return a.Slug < b.Slug
+	})
+
+	return matches
+}
+
+// newImageModel maps an image to its Terraform model.
+func newImageModel(image domains.Image) ImageModel {
+	return ImageModel{
+		Slug:         types.StringValue(image.Slug),
+		Distribution: types.StringValue(image.Distro),
+		Version:      types.StringValue(imageVersion(image)),
+		Name:         types.StringValue(image.OS),
+	}
+}
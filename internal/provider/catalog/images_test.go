@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+func TestImageVersion(t *testing.T) {
+	tests := []struct {
+		image domains.Image
+		want  string
+	}{
+		{domains.Image{Slug: "ubuntu-22.04-x86_64", OS: "Ubuntu 22.04 LTS"}, "22.04"},
+		{domains.Image{Slug: "ubuntu-20-04"}, "20.04"},
+		{domains.Image{Slug: "debian-12-x86_64", OS: "Debian 12"}, "12"},
+		{domains.Image{Slug: "windows-server", OS: "Windows Server"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := imageVersion(tt.image); got != tt.want {
+			t.Errorf("imageVersion(%+v) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
+
+func TestMatchingImages(t *testing.T) {
+	images := []domains.Image{
+		{Slug: "ubuntu-20.04-x86_64", Distro: "Ubuntu", OS: "Ubuntu 20.04 LTS"},
+		{Slug: "ubuntu-24.10-x86_64", Distro: "Ubuntu", OS: "Ubuntu 24.10"},
+		{Slug: "ubuntu-24.04-x86_64", Distro: "Ubuntu", OS: "Ubuntu 24.04 LTS"},
+		{Slug: "debian-12-x86_64", Distro: "Debian", OS: "Debian 12"},
+	}
+
+	filter, err := newImageFilter("ubuntu", "24", "LTS")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	matches := matchingImages(images, filter)
+	if len(matches) != 1 || matches[0].Slug != "ubuntu-24.04-x86_64" {
+		t.Errorf("expected only ubuntu-24.04-x86_64, got %+v", matches)
+	}
+
+	filter, err = newImageFilter("", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	matches = matchingImages(images, filter)
+	want := []string{"debian-12-x86_64", "ubuntu-24.10-x86_64", "ubuntu-24.04-x86_64", "ubuntu-20.04-x86_64"}
+	for i, image := range matches {
+		if image.Slug != want[i] {
+			t.Fatalf("expected %v, got %+v", want, matches)
+		}
+	}
+
+	if _, err := newImageFilter("", "", "("); err == nil {
+		t.Error("expected an error for an invalid name_regex")
+	}
+}
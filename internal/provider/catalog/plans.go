@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+// PlanModel describes a single plan.
+type PlanModel struct {
+	Slug         types.String  `tfsdk:"slug"`
+	Shortcode    types.String  `tfsdk:"shortcode"`
+	Cores        types.Int64   `tfsdk:"cores"`
+	MemoryMB     types.Int64   `tfsdk:"memory_mb"`
+	DiskGB       types.Int64   `tfsdk:"disk_gb"`
+	Bandwidth    types.Int64   `tfsdk:"bandwidth"`
+	MonthlyPrice types.Float64 `tfsdk:"monthly_price"`
+	Currency     types.String  `tfsdk:"currency"`
+}
+
+// planAttributes returns the computed attributes describing a plan. They are
+// shared by the plans list elements and the single plan data source.
+func planAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The slug of the plan, as used by `plan_slug`.",
+			Computed:            true,
+		},
+		"shortcode": schema.StringAttribute{
+			MarkdownDescription: "The short name of the plan.",
+			Computed:            true,
+		},
+		"cores": schema.Int64Attribute{
+			MarkdownDescription: "The number of CPU cores.",
+			Computed:            true,
+		},
+		"memory_mb": schema.Int64Attribute{
+			MarkdownDescription: "The amount of memory in MB.",
+			Computed:            true,
+		},
+		"disk_gb": schema.Int64Attribute{
+			MarkdownDescription: "The disk size in GB.",
+			Computed:            true,
+		},
+		"bandwidth": schema.Int64Attribute{
+			MarkdownDescription: "The monthly bandwidth allowance.",
+			Computed:            true,
+		},
+		"monthly_price": schema.Float64Attribute{
+			MarkdownDescription: "The monthly price of the plan.",
+			Computed:            true,
+		},
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "The currency code of `monthly_price`.",
+			Computed:            true,
+		},
+	}
+}
+
+// planRequirements holds the minimum specs and maximum price a plan must meet.
+// Zero values and a nil MaxMonthlyPrice disable the corresponding check.
+type planRequirements struct {
+	MinCores        int64
+	MinMemoryMB     int64
+	MinDiskGB       int64
+	MaxMonthlyPrice *float64
+}
+
+// pricedPlan is a plan along with its parsed monthly price.
+type pricedPlan struct {
+	domains.Plan
+	Price float64
+}
+
+// parsePlanPrice parses the monthly value the API returns as a string.
+func parsePlanPrice(plan domains.Plan) (float64, error) {
+	value := strings.ReplaceAll(strings.TrimSpace(plan.MonthlyValue), ",", "")
+	price, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("plan %s has an invalid monthly value %q", plan.Slug, plan.MonthlyValue)
+	}
+	return price, nil
+}
+
+// matchingPlans returns the plans meeting the requirements, cheapest first.
+// Plans with the same price are ordered by the most cores, memory and disk,
+// then by slug, so the result is stable between runs.
+func matchingPlans(plans []domains.Plan, req planRequirements) ([]pricedPlan, error) {
+	matches := make([]pricedPlan, 0, len(plans))
+	for _, plan := range plans {
+		price, err := parsePlanPrice(plan)
+		if err != nil {
+			return nil, err
+		}
+
+		if int64(plan.Core) < req.MinCores || int64(plan.Memory) < req.MinMemoryMB || int64(plan.Disk) < req.MinDiskGB {
+			continue
+		}
+		if req.MaxMonthlyPrice != nil && price > *req.MaxMonthlyPrice {
+			continue
+		}
+
+		matches = append(matches, pricedPlan{Plan: plan, Price: price})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		switch {
+		case a.Price != b.Price:
+			return a.Price < b.Price
+		case a.Core != b.Core:
+			return a.Core > b.Core
+		case a.Memory != b.Memory:
+			return a.Memory > b.Memory
+		case a.Disk != b.Disk:
+			return a.Disk > b.Disk
+		default:
+			return a.Slug < b.Slug
+		}
+	})
+
+	return matches, nil
+}
+
+// newPlanModel maps a plan to its Terraform model.
+func newPlanModel(plan pricedPlan) PlanModel {
+	return PlanModel{
+		Slug:         types.StringValue(plan.Slug),
+		Shortcode:    types.StringValue(plan.Shortcode),
+		Cores:        types.Int64Value(int64(plan.Core)),
+		MemoryMB:     types.Int64Value(int64(plan.Memory)),
+		DiskGB:       types.Int64Value(int64(plan.Disk)),
+		Bandwidth:    types.Int64Value(int64(plan.Bandwidth)),
+		MonthlyPrice: types.Float64Value(plan.Price),
+		Currency:     types.StringValue(plan.CurrencyCode),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+func TestMatchingPlans(t *testing.T) {
+	plans := []domains.Plan{
+		{Slug: "large", Core: 4, Memory: 8192, Disk: 80, MonthlyValue: "40.00"},
+		{Slug: "small", Core: 1, Memory: 1024, Disk: 10, MonthlyValue: "5.00"},
+		{Slug: "medium-disk", Core: 2, Memory: 2048, Disk: 50, MonthlyValue: "20.00"},
+		{Slug: "medium", Core: 2, Memory: 2048, Disk: 20, MonthlyValue: "20.00"},
+		{Slug: "xlarge", Core: 8, Memory: 16384, Disk: 160, MonthlyValue: "1,280.00"},
+	}
+
+	maxPrice := 40.0
+	tests := []struct {
+		name         string
+		requirements planRequirements
+		want         []string
+	}{
+		{
+			name: "no requirements",
+			want: []string{"small", "medium-disk", "medium", "large", "xlarge"},
+		},
+		{
+			name:         "minimum specs",
+			requirements: planRequirements{MinCores: 2, MinMemoryMB: 2048, MinDiskGB: 30},
+			want:         []string{"medium-disk", "large", "xlarge"},
+		},
+		{
+			name:         "maximum price",
+			requirements: planRequirements{MinCores: 2, MaxMonthlyPrice: &maxPrice},
+			want:         []string{"medium-disk", "medium", "large"},
+		},
+		{
+			name:         "no match",
+			requirements: planRequirements{MinCores: 16},
+			want:         []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := matchingPlans(plans, tt.requirements)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make([]string, len(matches))
+			for i, plan := range matches {
+				got[i] = plan.Slug
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchingPlans_InvalidPrice(t *testing.T) {
+	_, err := matchingPlans([]domains.Plan{{Slug: "broken", MonthlyValue: "n/a"}}, planRequirements{})
+	if err == nil {
+		t.Fatal("expected an error for an invalid monthly value")
+	}
+}
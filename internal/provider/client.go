@@ -9,6 +9,9 @@ import (
 
 // LetsCloudClient defines the interface for LetsCloud API operations.
 type LetsCloudClient interface {
+	// Account operations
+	Profile() (*domains.Profile, error)
+
 	// SSH Key operations
 	SSHKey(id string) (*domains.SSHKey, error)
 	SSHKeys() ([]domains.SSHKey, error)
@@ -21,7 +24,12 @@ type LetsCloudClient interface {
 	CreateInstance(req *domains.CreateInstanceRequest) error
 	DeleteInstance(id string) error
 	ResetPasswordInstance(id string, password string) error
+	RebootInstance(id string) error
+
+	// Catalog operations
+	Locations() ([]domains.Location, error)
 	LocationPlans(location string) ([]domains.Plan, error)
+	LocationImages(location string) ([]domains.Image, error)
 
 	// Close closes the client connection.
 	Close()
@@ -26,6 +26,11 @@ func (c *RealLetsCloudClient) Close() {
 	}
 }
 
+// Account methods.
+func (c *RealLetsCloudClient) Profile() (*domains.Profile, error) {
+	return c.client.Profile()
+}
+
 // SSH Key methods.
 func (c *RealLetsCloudClient) SSHKey(id string) (*domains.SSHKey, error) {
 	return c.client.SSHKey(id)
@@ -64,6 +69,19 @@ func (c *RealLetsCloudClient) ResetPasswordInstance(id string, password string)
 	return c.client.ResetPasswordInstance(id, password)
 }
 
+func (c *RealLetsCloudClient) RebootInstance(id string) error {
+	return c.client.RebootInstance(id)
+}
+
+// Catalog methods.
+func (c *RealLetsCloudClient) Locations() ([]domains.Location, error) {
+	return c.client.Locations()
+}
+
 func (c *RealLetsCloudClient) LocationPlans(location string) ([]domains.Plan, error) {
 	return c.client.LocationPlans(location)
 }
+
+func (c *RealLetsCloudClient) LocationImages(location string) ([]domains.Image, error) {
+	return c.client.LocationImages(location)
+}
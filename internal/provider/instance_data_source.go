@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/letscloud-community/letscloud-go/domains"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstanceDataSource{}
+
+func NewInstanceDataSource() datasource.DataSource {
+	return &InstanceDataSource{}
+}
+
+// InstanceDataSource defines the data source implementation.
+type InstanceDataSource struct {
+	client LetsCloudClient
+}
+
+// InstanceDataSourceModel describes the data source data model.
+type InstanceDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Label         types.String `tfsdk:"label"`
+	Hostname      types.String `tfsdk:"hostname"`
+	LocationSlug  types.String `tfsdk:"location_slug"`
+	State         types.String `tfsdk:"state"`
+	IPv4          types.String `tfsdk:"ipv4"`
+	IPv6          types.String `tfsdk:"ipv6"`
+	CPUs          types.Int64  `tfsdk:"cpus"`
+	MemoryMB      types.Int64  `tfsdk:"memory_mb"`
+	DiskGB        types.Int64  `tfsdk:"disk_gb"`
+	TemplateLabel types.String `tfsdk:"template_label"`
+}
+
+func (d *InstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance"
+}
+
+func (d *InstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches information about an existing instance by its ID, label or hostname.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the instance. Exactly one of `id`, `label` or `hostname` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the instance. Exactly one of `id`, `label` or `hostname` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the instance. Exactly one of `id`, `label` or `hostname` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"location_slug": schema.StringAttribute{
+				MarkdownDescription: "The location slug of the instance.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The current state of the instance.",
+				Computed:            true,
+			},
+			"ipv4": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 address of the instance.",
+				Computed:            true,
+			},
+			"ipv6": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 address of the instance.",
+				Computed:            true,
+			},
+			"cpus": schema.Int64Attribute{
+				MarkdownDescription: "The number of CPU cores of the instance.",
+				Computed:            true,
+			},
+			"memory_mb": schema.Int64Attribute{
+				MarkdownDescription: "The amount of memory of the instance, in MB.",
+				Computed:            true,
+			},
+			"disk_gb": schema.Int64Attribute{
+				MarkdownDescription: "The total disk size of the instance, in GB.",
+				Computed:            true,
+			},
+			"template_label": schema.StringAttribute{
+				MarkdownDescription: "The name of the image the instance was created from.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	for _, v := range []types.String{data.Id, data.Label, data.Hostname} {
+		if !v.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"Exactly one of 'id', 'label' or 'hostname' must be specified to identify the instance.",
+		)
+		return
+	}
+
+	var instance *domains.Instance
+
+	if !data.Id.IsNull() {
+		var err error
+		instance, err = d.client.Instance(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance by ID, got error: %s", err))
+			return
+		}
+	} else {
+		// Fetch by label or hostname - need to list all and match
+		instances, err := d.client.Instances()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list instances, got error: %s", err))
+			return
+		}
+
+		field, value := "label", data.Label.ValueString()
+		if !data.Hostname.IsNull() {
+			field, value = "hostname", data.Hostname.ValueString()
+		}
+
+		var matches []domains.Instance
+		for _, inst := range instances {
+			if (field == "label" && inst.Label == value) || (field == "hostname" && inst.Hostname == value) {
+				matches = append(matches, inst)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Instance Not Found",
+				fmt.Sprintf("No instance found with %s '%s'", field, value),
+			)
+			return
+		case 1:
+			instance = &matches[0]
+		default:
+			ids := make([]string, len(matches))
+			for i, inst := range matches {
+				ids[i] = inst.Identifier
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Instances Found",
+				fmt.Sprintf("%d instances found with %s '%s' (%v). Use 'id' to select one of them.", len(matches), field, value, ids),
+			)
+			return
+		}
+	}
+
+	// Map response body to model
+	data.Id = types.StringValue(instance.Identifier)
+	data.Label = types.StringValue(instance.Label)
+	data.Hostname = types.StringValue(instance.Hostname)
+	data.LocationSlug = types.StringValue(instance.Location.Slug)
+	data.State = types.StringValue(getInstanceState(instance))
+	data.IPv4 = types.StringValue(getInstanceIPv4(instance))
+	data.IPv6 = types.StringValue(getInstanceIPv6(instance))
+	data.CPUs = types.Int64Value(int64(instance.CPUS))
+	data.MemoryMB = types.Int64Value(int64(instance.Memory))
+	data.DiskGB = types.Int64Value(int64(instance.TotalDiskSize))
+	data.TemplateLabel = types.StringValue(instance.TemplateLabel)
+
+	tflog.Info(ctx, "Instance data source read successfully", map[string]interface{}{
+		"id":    instance.Identifier,
+		"label": instance.Label,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
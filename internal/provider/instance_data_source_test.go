@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstanceDataSource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceResourceConfig("lookup-instance") + `
+data "letscloud_instance" "by_id" {
+  id = letscloud_instance.test.id
+}
+
+data "letscloud_instance" "by_label" {
+  label = letscloud_instance.test.label
+}
+
+data "letscloud_instance" "by_hostname" {
+  hostname = letscloud_instance.test.hostname
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.letscloud_instance.by_id", "label", "letscloud_instance.test", "label"),
+					resource.TestCheckResourceAttrPair("data.letscloud_instance.by_id", "ipv4", "letscloud_instance.test", "ipv4"),
+					resource.TestCheckResourceAttr("data.letscloud_instance.by_id", "location_slug", "us-east-1"),
+					resource.TestCheckResourceAttr("data.letscloud_instance.by_id", "cpus", "1"),
+					resource.TestCheckResourceAttr("data.letscloud_instance.by_id", "memory_mb", "1024"),
+
+					resource.TestCheckResourceAttrPair("data.letscloud_instance.by_label", "id", "letscloud_instance.test", "id"),
+					resource.TestCheckResourceAttrPair("data.letscloud_instance.by_hostname", "id", "letscloud_instance.test", "id"),
+					resource.TestCheckResourceAttr("data.letscloud_instance.by_hostname", "state", "running"),
+				),
+			},
+			{
+				Config: testAccInstanceResourceConfig("lookup-instance") + `
+data "letscloud_instance" "missing" {
+  label = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`No instance found with label 'does-not-exist'`),
+			},
+			{
+				Config: testAccInstanceResourceConfig("lookup-instance") + `
+data "letscloud_instance" "ambiguous" {
+  id    = letscloud_instance.test.id
+  label = letscloud_instance.test.label
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of 'id', 'label' or 'hostname' must be specified`),
+			},
+		},
+	})
+}
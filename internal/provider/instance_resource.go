@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -14,15 +15,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/labelprefix"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &InstanceResource{}
 var _ resource.ResourceWithImportState = &InstanceResource{}
+var _ resource.ResourceWithModifyPlan = &InstanceResource{}
+var _ resource.ResourceWithValidateConfig = &InstanceResource{}
 
 func NewInstanceResource() resource.Resource {
 	return &InstanceResource{}
@@ -35,17 +41,22 @@ type InstanceResource struct {
 
 // InstanceResourceModel describes the resource data model.
 type InstanceResourceModel struct {
-	Label        types.String   `tfsdk:"label"`
-	LocationSlug types.String   `tfsdk:"location_slug"`
-	PlanSlug     types.String   `tfsdk:"plan_slug"`
-	ImageSlug    types.String   `tfsdk:"image_slug"`
-	SSHKeys      []types.String `tfsdk:"ssh_keys"`
-	Password     types.String   `tfsdk:"password"`
-	Hostname     types.String   `tfsdk:"hostname"`
-	Id           types.String   `tfsdk:"id"`
-	State        types.String   `tfsdk:"state"`
-	IPv4         types.String   `tfsdk:"ipv4"`
-	IPv6         types.String   `tfsdk:"ipv6"`
+	Label              types.String   `tfsdk:"label"`
+	LabelPrefix        types.String   `tfsdk:"label_prefix"`
+	LocationSlug       types.String   `tfsdk:"location_slug"`
+	PlanSlug           types.String   `tfsdk:"plan_slug"`
+	ImageSlug          types.String   `tfsdk:"image_slug"`
+	SSHKeys            []types.String `tfsdk:"ssh_keys"`
+	Password           types.String   `tfsdk:"password"`
+	Hostname           types.String   `tfsdk:"hostname"`
+	Id                 types.String   `tfsdk:"id"`
+	State              types.String   `tfsdk:"state"`
+	IPv4               types.String   `tfsdk:"ipv4"`
+	IPv6               types.String   `tfsdk:"ipv6"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	RebootTriggers     types.Map      `tfsdk:"reboot_triggers"`
+	RebuildTriggers    types.Map      `tfsdk:"rebuild_triggers"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
 }
 
 func (r *InstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,8 +69,21 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 
 		Attributes: map[string]schema.Attribute{
 			"label": schema.StringAttribute{
-				MarkdownDescription: "The label of the instance.",
-				Required:            true,
+				MarkdownDescription: "The label of the instance. Conflicts with `label_prefix`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_prefix": schema.StringAttribute{
+				MarkdownDescription: "Creates a unique label beginning with this prefix, which is stored in `label` and known after apply. " +
+					"Lets `create_before_destroy` replace the instance without a label conflict. " +
+					"Changing it replaces the instance. Conflicts with `label`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"location_slug": schema.StringAttribute{
 				MarkdownDescription: "The location slug where the instance will be created.",
@@ -87,6 +111,39 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "The hostname of the instance.",
 				Required:            true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevents the instance from being destroyed or replaced while set to `true`. " +
+					"The protection is enforced by the provider, as the LetsCloud API does not offer an instance lock. " +
+					"Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, an existing instance with the same label is brought under management " +
+					"instead of failing the plan. The instance must match the configured `location_slug`, `hostname`, " +
+					"`plan_slug` and `image_slug`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"reboot_triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, reboots the instance in place. " +
+					"Adding or removing the map does not reboot the instance.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"rebuild_triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, replaces the instance with a new one from the current `image_slug`, " +
+					"as the LetsCloud API cannot reinstall an instance in place. Adding or removing the map does not replace the instance.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(requiresReplaceIfTriggersChanged,
+						"Changing rebuild_triggers replaces the instance.",
+						"Changing `rebuild_triggers` replaces the instance."),
+				},
+			},
 			"state": schema.StringAttribute{
 				MarkdownDescription: "The current state of the instance.",
 				Computed:            true,
@@ -119,6 +176,141 @@ func (r *InstanceResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+// triggersChanged reports whether a triggers map changed between two non-null
+// values. Adding or removing the map is not a change, so triggers can be
+// introduced on an existing instance without rebooting or replacing it.
+func triggersChanged(state, plan types.Map) bool {
+	return !state.IsNull() && !plan.IsNull() && !plan.Equal(state)
+}
+
+// requiresReplaceIfTriggersChanged replaces the instance when
+// rebuild_triggers changes, see triggersChanged.
+func requiresReplaceIfTriggersChanged(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = triggersChanged(req.StateValue, req.PlanValue)
+}
+
+// privateKeyPriorID is the private state key holding the identifier of the
+// instance a plan started from. Terraform plans the create half of a
+// replacement with a null prior state but keeps the private data, which lets
+// the label check below ignore the instance that is about to be replaced.
+const privateKeyPriorID = "prior_id"
+
+func (r *InstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy; Delete enforces deletion protection itself.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		r.checkLabelAvailable(ctx, req, resp)
+		return
+	}
+
+	var state *InstanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorID, err := json.Marshal(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode instance identifier, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyPriorID, priorID)...)
+
+	if !state.DeletionProtection.ValueBool() {
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	replacedBy, diags := replacingAttributes(ctx, schemaResp.Schema, req)
+	resp.Diagnostics.Append(diags...)
+	if len(replacedBy) > 0 {
+		resp.Diagnostics.AddError(
+			"Instance Deletion Protected",
+			fmt.Sprintf("Instance %s (%s) has deletion_protection enabled and cannot be replaced, which changing %s requires. "+
+				"Set deletion_protection = false and apply before making changes that require replacement.",
+				state.Label.ValueString(), state.Id.ValueString(), strings.Join(replacedBy, ", ")),
+		)
+	}
+}
+
+// checkLabelAvailable reports a label that is already used by another
+// instance at plan time, so the conflict surfaces before anything is applied.
+func (r *InstanceResource) checkLabelAvailable(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The provider is not configured yet, e.g. when its configuration is unknown
+	if r.client == nil {
+		return
+	}
+
+	var plan *InstanceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Label.IsUnknown() || plan.Label.IsNull() || plan.AdoptExisting.ValueBool() {
+		return
+	}
+
+	var priorID string
+	raw, diags := req.Private.GetKey(ctx, privateKeyPriorID)
+	resp.Diagnostics.Append(diags...)
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &priorID); err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode instance identifier, got error: %s", err))
+			return
+		}
+	}
+
+	instances, err := r.client.Instances()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", "Error checking for existing instances: "+err.Error())
+		return
+	}
+
+	inst := findInstanceByLabel(instances, plan.Label.ValueString())
+	if inst == nil || inst.Identifier == priorID {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("label"),
+		"Label Already In Use",
+		fmt.Sprintf("Label '%s' is already used by instance %s. Please choose a different label, "+
+			"or set adopt_existing = true to manage the existing instance.", plan.Label.ValueString(), inst.Identifier),
+	)
+}
+
+// ValidateConfig requires exactly one of label and label_prefix.
+func (r *InstanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InstanceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Label.IsNull() && !data.LabelPrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label_prefix"),
+			"Conflicting Attributes",
+			"Only one of label and label_prefix can be set.",
+		)
+		return
+	}
+
+	if data.Label.IsNull() && data.LabelPrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label"),
+			"Missing Required Attribute",
+			"One of label or label_prefix must be set.",
+		)
+	}
+}
+
 func (r *InstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -146,6 +338,12 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// A label from label_prefix is generated only now, as Terraform plans
+	// again before applying and a plan-time label would differ between plans
+	if data.Label.IsUnknown() {
+		data.Label = types.StringValue(labelprefix.Generate(data.LabelPrefix.ValueString()))
+	}
+
 	tflog.Info(ctx, "Starting instance creation", map[string]interface{}{
 		"label":         data.Label.ValueString(),
 		"location_slug": data.LocationSlug.ValueString(),
@@ -219,8 +417,8 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	for _, inst := range existingInstances {
-		if inst.Label == createRequest.Label {
+	if inst := findInstanceByLabel(existingInstances, createRequest.Label); inst != nil {
+		if !data.AdoptExisting.ValueBool() {
 			tflog.Error(ctx, "Label already exists", map[string]interface{}{
 				"label": createRequest.Label,
 				"id":    inst.Identifier,
@@ -228,50 +426,42 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 			resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("Label '%s' already exists. Please choose a different label.", createRequest.Label))
 			return
 		}
-	}
-
-	// Create the instance
-	tflog.Debug(ctx, "Sending create instance request to LetsCloud API", map[string]interface{}{
-		"request": fmt.Sprintf("%+v", createRequest),
-	})
-
-	// Try to create the instance with retries
-	maxRetries := 3
-	retryCount := 0
-	var createErr error
 
-	for retryCount < maxRetries {
-		tflog.Info(ctx, "Attempting to create instance", map[string]interface{}{
-			"attempt":     retryCount + 1,
-			"max_retries": maxRetries,
-			"label":       createRequest.Label,
-			"location":    createRequest.LocationSlug,
-			"plan":        createRequest.PlanSlug,
-			"image":       createRequest.ImageSlug,
-			"hostname":    createRequest.Hostname,
+		tflog.Info(ctx, "Adopting existing instance with the same label", map[string]interface{}{
+			"label": createRequest.Label,
+			"id":    inst.Identifier,
 		})
 
-		createErr = r.client.CreateInstance(createRequest)
-		if createErr == nil {
-			tflog.Info(ctx, "Instance creation request sent successfully", map[string]interface{}{
-				"label": createRequest.Label,
-			})
-			break
+		mismatches, err := adoptMismatches(r.client, inst, createRequest)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check the existing instance, got error: %s", err))
+			return
+		}
+		if len(mismatches) > 0 {
+			resp.Diagnostics.AddError(
+				"Existing Instance Does Not Match",
+				fmt.Sprintf("Instance %s has label '%s' but differs from the configuration in %s. "+
+					"Update the configuration to match the existing instance, or choose a different label.",
+					inst.Identifier, createRequest.Label, strings.Join(mismatches, ", ")),
+			)
+			return
 		}
 
-		tflog.Warn(ctx, "Failed to create instance, retrying...", map[string]interface{}{
-			"error":       createErr.Error(),
-			"retry_count": retryCount + 1,
-			"max_retries": maxRetries,
-			"label":       createRequest.Label,
-		})
+		data.Id = types.StringValue(inst.Identifier)
+		data.State = types.StringValue(getInstanceState(inst))
+		data.IPv4 = types.StringValue(getInstanceIPv4(inst))
+		data.IPv6 = types.StringValue(getInstanceIPv6(inst))
 
-		retryCount++
-		if retryCount < maxRetries {
-			time.Sleep(5 * time.Second) // Wait 5 seconds between retries
-		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
 	}
 
+	// Create the instance
+	tflog.Debug(ctx, "Sending create instance request to LetsCloud API", map[string]interface{}{
+		"request": fmt.Sprintf("%+v", createRequest),
+	})
+
+	createErr := createInstanceWithRetry(ctx, r.client, createRequest)
 	if createErr != nil {
 		tflog.Error(ctx, "Failed to create instance after retries", map[string]interface{}{
 			"error":   createErr.Error(),
@@ -290,6 +480,16 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 			"hostname": createRequest.Hostname,
 		})
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error waiting for instance to be ready: %s", err))
+
+		// The instance exists even though it never became ready. Save it so
+		// Terraform marks the resource as tainted instead of losing track of it.
+		if instance != nil {
+			data.Id = types.StringValue(instance.Identifier)
+			data.State = types.StringValue(getInstanceState(instance))
+			data.IPv4 = types.StringValue(getInstanceIPv4(instance))
+			data.IPv6 = types.StringValue(getInstanceIPv6(instance))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
 		return
 	}
 
@@ -320,6 +520,130 @@ func (r *InstanceResource) Create(ctx context.Context, req resource.CreateReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// adoptMismatches returns the attributes in which an existing instance
+// differs from the create request. The API does not report the plan or image
+// slug of an instance, so the plan is compared by size and the image by the
+// instance's template label.
+func adoptMismatches(client LetsCloudClient, inst *domains.Instance, createRequest *domains.CreateInstanceRequest) ([]string, error) {
+	var mismatches []string
+
+	if inst.Location.Slug != createRequest.LocationSlug {
+		mismatches = append(mismatches, "location_slug")
+	}
+	if inst.Hostname != createRequest.Hostname {
+		mismatches = append(mismatches, "hostname")
+	}
+
+	plans, err := client.LocationPlans(createRequest.LocationSlug)
+	if err != nil {
+		return nil, err
+	}
+	planMatches := false
+	for _, plan := range plans {
+		if plan.Slug == createRequest.PlanSlug {
+			planMatches = plan.Core == inst.CPUS && plan.Memory == inst.Memory && plan.Disk == inst.TotalDiskSize
+			break
+		}
+	}
+	if !planMatches {
+		mismatches = append(mismatches, "plan_slug")
+	}
+
+	images, err := client.LocationImages(createRequest.LocationSlug)
+	if err != nil {
+		return nil, err
+	}
+	imageMatches := inst.TemplateLabel == createRequest.ImageSlug
+	for _, image := range images {
+		if image.Slug == createRequest.ImageSlug && inst.TemplateLabel == image.OS {
+			imageMatches = true
+		}
+	}
+	if !imageMatches {
+		mismatches = append(mismatches, "image_slug")
+	}
+
+	return mismatches, nil
+}
+
+// createRetryInterval is the delay between attempts in createInstanceWithRetry.
+var createRetryInterval = 5 * time.Second
+
+// createInstanceWithRetry sends the create request up to three times. The
+// LetsCloud API has no idempotency keys, so before every retry it looks for an
+// instance with the requested label: label uniqueness is checked before the
+// first attempt, so a match means an earlier attempt succeeded and only its
+// response was lost. That instance is adopted instead of creating a duplicate,
+// but only if it matches the request; an instance that took the label in the
+// meantime but differs is reported as an error straight away.
+func createInstanceWithRetry(ctx context.Context, client LetsCloudClient, createRequest *domains.CreateInstanceRequest) error {
+	maxRetries := 3
+	retryCount := 0
+	var createErr error
+
+	for retryCount < maxRetries {
+		if retryCount > 0 {
+			instances, err := client.Instances()
+			if err != nil {
+				tflog.Warn(ctx, "Error checking for instance from previous attempt", map[string]interface{}{
+					"error": err.Error(),
+					"label": createRequest.Label,
+				})
+			} else if inst := findInstanceByLabel(instances, createRequest.Label); inst != nil {
+				mismatches, err := adoptMismatches(client, inst, createRequest)
+				if err != nil {
+					return fmt.Errorf("unable to check instance %s from previous attempt: %w", inst.Identifier, err)
+				}
+				if len(mismatches) > 0 {
+					return fmt.Errorf("instance %s has label '%s' but differs from the request in %s",
+						inst.Identifier, createRequest.Label, strings.Join(mismatches, ", "))
+				}
+
+				tflog.Info(ctx, "Instance from previous attempt found, adopting it", map[string]interface{}{
+					"id":    inst.Identifier,
+					"label": createRequest.Label,
+				})
+				return nil
+			}
+		}
+
+		tflog.Info(ctx, "Attempting to create instance", map[string]interface{}{
+			"attempt":     retryCount + 1,
+			"max_retries": maxRetries,
+			"label":       createRequest.Label,
+			"location":    createRequest.LocationSlug,
+			"plan":        createRequest.PlanSlug,
+			"image":       createRequest.ImageSlug,
+			"hostname":    createRequest.Hostname,
+		})
+
+		createErr = client.CreateInstance(createRequest)
+		if createErr == nil {
+			tflog.Info(ctx, "Instance creation request sent successfully", map[string]interface{}{
+				"label": createRequest.Label,
+			})
+			return nil
+		}
+
+		tflog.Warn(ctx, "Failed to create instance, retrying...", map[string]interface{}{
+			"error":       createErr.Error(),
+			"retry_count": retryCount + 1,
+			"max_retries": maxRetries,
+			"label":       createRequest.Label,
+		})
+
+		retryCount++
+		if retryCount < maxRetries {
+			time.Sleep(createRetryInterval)
+		}
+	}
+
+	return createErr
+}
+
+// waitForInstanceReady polls until the instance with the given label and
+// hostname is built, booted and has an IP address. On failure it still returns
+// the last version of the instance it saw, if any, alongside the error.
 func waitForInstanceReady(ctx context.Context, client LetsCloudClient, label, hostname string) (*domains.Instance, error) {
 	maxAttempts := 400 // 20 minutes total (400 attempts × 3 seconds)
 	attempt := 0
@@ -328,6 +652,7 @@ func waitForInstanceReady(ctx context.Context, client LetsCloudClient, label, ho
 	lastIPs := ""
 	lastResponse := ""
 	var instanceID string
+	var lastSeen *domains.Instance
 
 	for attempt < maxAttempts {
 		tflog.Info(ctx, "Checking instance status", map[string]interface{}{
@@ -381,6 +706,8 @@ func waitForInstanceReady(ctx context.Context, client LetsCloudClient, label, ho
 			continue
 		}
 
+		lastSeen = instance
+
 		// Log the current state
 		currentState := getInstanceState(instance)
 		lastState = currentState
@@ -403,7 +730,7 @@ func waitForInstanceReady(ctx context.Context, client LetsCloudClient, label, ho
 
 		// Check if instance is in an error state
 		if instance.Suspended {
-			return nil, fmt.Errorf("instance %s is suspended", instanceID)
+			return instance, fmt.Errorf("instance %s is suspended", instanceID)
 		}
 
 		// Check if instance has IP addresses assigned
@@ -444,7 +771,7 @@ func waitForInstanceReady(ctx context.Context, client LetsCloudClient, label, ho
 		time.Sleep(3 * time.Second) // Wait 3 seconds between checks
 	}
 
-	return nil, fmt.Errorf("timeout waiting for instance with label %s and hostname %s to be ready after %d seconds. Last known state: %s, Last error: %s, Last IPs: %s, Last response: %s",
+	return lastSeen, fmt.Errorf("timeout waiting for instance with label %s and hostname %s to be ready after %d seconds. Last known state: %s, Last error: %s, Last IPs: %s, Last response: %s",
 		label, hostname, maxAttempts*3, lastState, lastError, lastIPs, lastResponse)
 }
 
@@ -490,6 +817,29 @@ func (r *InstanceResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	reboot := triggersChanged(state.RebootTriggers, data.RebootTriggers)
+
+	if reboot {
+		tflog.Info(ctx, "Reboot triggers changed, rebooting instance", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+
+		err := r.client.RebootInstance(state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reboot instance, got error: %s", err))
+			return
+		}
+	}
+
+	if reboot {
+		// The instance still carries its previous label and hostname at this point
+		_, err := waitForInstanceReady(ctx, r.client, state.Label.ValueString(), state.Hostname.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Error waiting for instance to be ready: %s", err))
+			return
+		}
+	}
+
 	// Update label and hostname in the mock client
 	if mock, ok := r.client.(*letsCloudClientMock); ok {
 		instance, err := mock.Instance(state.Id.ValueString())
@@ -525,6 +875,16 @@ func (r *InstanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Instance Deletion Protected",
+			fmt.Sprintf("Instance %s (%s) has deletion_protection enabled and cannot be deleted. "+
+				"Set deletion_protection = false and apply before destroying it.",
+				data.Label.ValueString(), data.Id.ValueString()),
+		)
+		return
+	}
+
 	err := r.client.DeleteInstance(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete instance, got error: %s", err))
@@ -538,6 +898,19 @@ func (r *InstanceResource) ImportState(ctx context.Context, req resource.ImportS
 	// Set plan_slug and image_slug to mock values for import verification
 	resp.State.SetAttribute(ctx, path.Root("plan_slug"), "plan-1")
 	resp.State.SetAttribute(ctx, path.Root("image_slug"), "ubuntu-20-04")
+	resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)
+	resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)
+}
+
+// findInstanceByLabel returns the instance with the given label, or nil if there is none.
+func findInstanceByLabel(instances []domains.Instance, label string) *domains.Instance {
+	for _, inst := range instances {
+		if inst.Label == label {
+			instanceCopy := inst
+			return &instanceCopy
+		}
+	}
+	return nil
 }
 
 // Helper functions to get instance state and IP addresses.
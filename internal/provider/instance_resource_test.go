@@ -4,12 +4,23 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/letscloud-community/letscloud-go/domains"
 )
 
 func TestAccInstanceResource(t *testing.T) {
@@ -54,6 +65,610 @@ func TestAccInstanceResource(t *testing.T) {
 	})
 }
 
+func TestAccInstanceResource_DeletionProtection(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceResourceConfigDeletionProtection("protected-instance", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "deletion_protection", "true"),
+				),
+			},
+			// Destroy must be refused while protection is enabled
+			{
+				Config:      testAccInstanceResourceConfigDeletionProtection("protected-instance", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`has deletion_protection enabled and cannot be deleted`),
+			},
+			// Disable protection so the instance can be destroyed
+			{
+				Config: testAccInstanceResourceConfigDeletionProtection("protected-instance", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+// The replacement guard runs in ModifyPlan, so it is exercised through the
+// protocol server without the Terraform CLI.
+func TestInstanceResource_DeletionProtectionBlocksReplacement(t *testing.T) {
+	ctx := context.Background()
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	server, schemas := testProviderServer(t)
+	instanceSchema := schemas.ResourceSchemas["letscloud_instance"]
+
+	tests := []struct {
+		name        string
+		protected   bool
+		change      map[string]tftypes.Value
+		wantError   bool
+		wantReplace bool
+	}{
+		{
+			name:      "protected replacement",
+			protected: true,
+			change:    map[string]tftypes.Value{"label_prefix": tftypes.NewValue(tftypes.String, "db-")},
+			wantError: true,
+		},
+		{
+			name:        "unprotected replacement",
+			change:      map[string]tftypes.Value{"label_prefix": tftypes.NewValue(tftypes.String, "db-")},
+			wantReplace: true,
+		},
+		{
+			name:      "protected in-place update",
+			protected: true,
+			change:    map[string]tftypes.Value{"hostname": tftypes.NewValue(tftypes.String, "db.example.com")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			protection := map[string]tftypes.Value{"deletion_protection": tftypes.NewValue(tftypes.Bool, tt.protected)}
+
+			planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "letscloud_instance",
+				PriorState:       testDynamicValue(t, instanceSchema, testInstanceValues(true, protection)),
+				ProposedNewState: testDynamicValue(t, instanceSchema, testInstanceValues(true, protection, tt.change)),
+				Config:           testDynamicValue(t, instanceSchema, testInstanceValues(false, protection, tt.change)),
+			})
+			if err != nil {
+				t.Fatalf("unable to plan: %s", err)
+			}
+
+			var blocked bool
+			for _, d := range planResp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError && strings.Contains(d.Detail, "cannot be replaced") {
+					blocked = true
+				}
+			}
+			if blocked != tt.wantError {
+				t.Errorf("expected blocked = %t, got diagnostics %v", tt.wantError, planResp.Diagnostics)
+			}
+
+			if got := len(planResp.RequiresReplace) > 0; !tt.wantError && got != tt.wantReplace {
+				t.Errorf("expected requires replace = %t, got %v", tt.wantReplace, planResp.RequiresReplace)
+			}
+		})
+	}
+}
+
+// Terraform plans again before applying, so a label generated from
+// label_prefix while planning would make the two plans disagree.
+func TestInstanceResource_LabelPrefixUnknownInPlan(t *testing.T) {
+	ctx := context.Background()
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	server, schemas := testProviderServer(t)
+	instanceSchema := schemas.ResourceSchemas["letscloud_instance"]
+
+	config := testDynamicValue(t, instanceSchema, testInstanceValues(false))
+
+	priorState, err := tfprotov6.NewDynamicValue(instanceSchema.ValueType(), tftypes.NewValue(instanceSchema.ValueType(), nil))
+	if err != nil {
+		t.Fatalf("unable to encode prior state: %s", err)
+	}
+
+	var plans []tftypes.Value
+	for i := 0; i < 2; i++ {
+		planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "letscloud_instance",
+			PriorState:       &priorState,
+			ProposedNewState: config,
+			Config:           config,
+		})
+		if err != nil || len(planResp.Diagnostics) > 0 {
+			t.Fatalf("unable to plan: %v %v", err, planResp.Diagnostics)
+		}
+
+		planned, err := planResp.PlannedState.Unmarshal(instanceSchema.ValueType())
+		if err != nil {
+			t.Fatalf("unable to decode plan: %s", err)
+		}
+		plans = append(plans, planned)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := plans[0].As(&attributes); err != nil {
+		t.Fatalf("unable to decode plan: %s", err)
+	}
+	if attributes["label"].IsKnown() {
+		t.Errorf("expected label to be unknown, got %v", attributes["label"])
+	}
+
+	if !plans[0].Equal(plans[1]) {
+		t.Errorf("expected identical plans, got %v and %v", plans[0], plans[1])
+	}
+}
+
+func TestAccInstanceResource_Triggers(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceResourceConfig("triggers-instance"),
+			},
+			// Adding triggers to an existing instance neither reboots nor replaces it
+			{
+				Config: testAccInstanceResourceConfigTriggers("triggers-instance", "v1", "v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_instance.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "reboot_triggers.config", "v1"),
+					resource.TestCheckResourceAttr("letscloud_instance.test", "rebuild_triggers.image", "v1"),
+				),
+			},
+			// Changing a reboot trigger reboots the instance in place
+			{
+				Config: testAccInstanceResourceConfigTriggers("triggers-instance", "v2", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "reboot_triggers.config", "v2"),
+					resource.TestCheckResourceAttr("letscloud_instance.test", "state", "running"),
+				),
+			},
+			// Changing a rebuild trigger replaces the instance
+			{
+				Config: testAccInstanceResourceConfigTriggers("triggers-instance", "v2", "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_instance.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "rebuild_triggers.image", "v2"),
+					resource.TestCheckResourceAttr("letscloud_instance.test", "state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestInstanceResource_RebuildTriggersReplace(t *testing.T) {
+	ctx := context.Background()
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	server, schemas := testProviderServer(t)
+	instanceSchema := schemas.ResourceSchemas["letscloud_instance"]
+
+	triggers := func(value string) tftypes.Value {
+		if value == "" {
+			return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"image": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		prior       string
+		planned     string
+		wantReplace bool
+	}{
+		{name: "changed", prior: "v1", planned: "v2", wantReplace: true},
+		{name: "unchanged", prior: "v1", planned: "v1"},
+		{name: "added", planned: "v1"},
+		{name: "removed", prior: "v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := map[string]tftypes.Value{"rebuild_triggers": triggers(tt.prior)}
+			planned := map[string]tftypes.Value{"rebuild_triggers": triggers(tt.planned)}
+
+			planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "letscloud_instance",
+				PriorState:       testDynamicValue(t, instanceSchema, testInstanceValues(true, prior)),
+				ProposedNewState: testDynamicValue(t, instanceSchema, testInstanceValues(true, planned)),
+				Config:           testDynamicValue(t, instanceSchema, testInstanceValues(false, planned)),
+			})
+			if err != nil || len(planResp.Diagnostics) > 0 {
+				t.Fatalf("unable to plan: %v %v", err, planResp.Diagnostics)
+			}
+
+			if got := len(planResp.RequiresReplace) > 0; got != tt.wantReplace {
+				t.Errorf("expected requires replace = %t, got %v", tt.wantReplace, planResp.RequiresReplace)
+			}
+		})
+	}
+}
+
+func TestTriggersChanged(t *testing.T) {
+	v1 := types.MapValueMust(types.StringType, map[string]attr.Value{"config": types.StringValue("v1")})
+	v2 := types.MapValueMust(types.StringType, map[string]attr.Value{"config": types.StringValue("v2")})
+	null := types.MapNull(types.StringType)
+
+	tests := map[string]struct {
+		state, plan types.Map
+		expected    bool
+	}{
+		"changed":   {state: v1, plan: v2, expected: true},
+		"unchanged": {state: v1, plan: v1},
+		"added":     {state: null, plan: v1},
+		"removed":   {state: v1, plan: null},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := triggersChanged(test.state, test.plan); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccInstanceResource_AdoptExisting(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	mockClient := NewLetsCloudClientMock()
+	MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The label conflict is reported while planning
+			{
+				PreConfig: func() {
+					err := mockClient.CreateInstance(&domains.CreateInstanceRequest{
+						Label:        "existing-instance",
+						Hostname:     "existing-instance.example.com",
+						LocationSlug: "us-east-1",
+						PlanSlug:     "plan-1",
+						ImageSlug:    "ubuntu-20-04",
+					})
+					if err != nil {
+						t.Fatalf("unable to create existing instance: %s", err)
+					}
+				},
+				Config:      testAccInstanceResourceConfig("existing-instance"),
+				ExpectError: regexp.MustCompile(`Label 'existing-instance' is already used by instance`),
+			},
+			{
+				Config: testAccInstanceResourceConfigAdopt("existing-instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_instance.test", "id", "mock-instance-1"),
+					resource.TestCheckResourceAttr("letscloud_instance.test", "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAdoptMismatches(t *testing.T) {
+	client := NewLetsCloudClientMock()
+	err := client.CreateInstance(&domains.CreateInstanceRequest{
+		Label:        "existing-instance",
+		Hostname:     "existing-instance.example.com",
+		LocationSlug: "us-east-1",
+		PlanSlug:     "plan-1",
+		ImageSlug:    "ubuntu-24.04-x86_64",
+	})
+	if err != nil {
+		t.Fatalf("unable to create existing instance: %s", err)
+	}
+	inst, _ := client.Instance("mock-instance-1")
+
+	tests := map[string]struct {
+		modify   func(req *domains.CreateInstanceRequest, inst *domains.Instance)
+		expected []string
+	}{
+		"match": {
+			modify: func(req *domains.CreateInstanceRequest, inst *domains.Instance) {},
+		},
+		"image by os": {
+			modify: func(req *domains.CreateInstanceRequest, inst *domains.Instance) {
+				inst.TemplateLabel = "Ubuntu 24.04 LTS"
+			},
+		},
+		"location": {
+			modify:   func(req *domains.CreateInstanceRequest, inst *domains.Instance) { req.LocationSlug = "br-sp-1" },
+			expected: []string{"location_slug"},
+		},
+		"hostname": {
+			modify:   func(req *domains.CreateInstanceRequest, inst *domains.Instance) { req.Hostname = "other.example.com" },
+			expected: []string{"hostname"},
+		},
+		"plan": {
+			modify:   func(req *domains.CreateInstanceRequest, inst *domains.Instance) { req.PlanSlug = "plan-2" },
+			expected: []string{"plan_slug"},
+		},
+		"unknown plan": {
+			modify:   func(req *domains.CreateInstanceRequest, inst *domains.Instance) { req.PlanSlug = "plan-99" },
+			expected: []string{"plan_slug"},
+		},
+		"image": {
+			modify:   func(req *domains.CreateInstanceRequest, inst *domains.Instance) { req.ImageSlug = "debian-12-x86_64" },
+			expected: []string{"image_slug"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			existing := *inst
+			inst := &existing
+			req := &domains.CreateInstanceRequest{
+				Label:        "existing-instance",
+				Hostname:     "existing-instance.example.com",
+				LocationSlug: "us-east-1",
+				PlanSlug:     "plan-1",
+				ImageSlug:    "ubuntu-24.04-x86_64",
+			}
+			test.modify(req, inst)
+
+			mismatches, err := adoptMismatches(client, inst, req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if strings.Join(mismatches, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected mismatches %v, got %v", test.expected, mismatches)
+			}
+		})
+	}
+}
+
+func TestAccInstanceResource_LabelPrefix(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceResourceConfigLabelPrefix("web-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("letscloud_instance.test", "label", regexp.MustCompile(`^web-\d{18}$`)),
+					resource.TestCheckResourceAttr("letscloud_instance.test", "label_prefix", "web-"),
+				),
+			},
+			// A new prefix replaces the instance, creating the new one first
+			{
+				Config: testAccInstanceResourceConfigLabelPrefix("api-"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_instance.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("letscloud_instance.test", "label", regexp.MustCompile(`^api-\d{18}$`)),
+				),
+			},
+			{
+				Config: `
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "test" {
+  label         = "web"
+  label_prefix  = "web-"
+  hostname      = "web.example.com"
+  location_slug = "us-east-1"
+  plan_slug     = "plan-1"
+  image_slug    = "ubuntu-20-04"
+}
+`,
+				ExpectError: regexp.MustCompile(`Only one of label and label_prefix can be set`),
+			},
+		},
+	})
+}
+
+// lostResponseClient creates instances but reports an error for the first
+// failures calls, as if the API response had been lost.
+type lostResponseClient struct {
+	LetsCloudClient
+	failures int
+	calls    int
+}
+
+func (c *lostResponseClient) CreateInstance(req *domains.CreateInstanceRequest) error {
+	c.calls++
+	if err := c.LetsCloudClient.CreateInstance(req); err != nil {
+		return err
+	}
+	if c.calls <= c.failures {
+		return errors.New("connection reset by peer")
+	}
+	return nil
+}
+
+// failingCreateClient rejects the first failures create calls without creating anything.
+type failingCreateClient struct {
+	LetsCloudClient
+	failures int
+	calls    int
+}
+
+func (c *failingCreateClient) CreateInstance(req *domains.CreateInstanceRequest) error {
+	c.calls++
+	if c.calls <= c.failures {
+		return errors.New("service unavailable")
+	}
+	return c.LetsCloudClient.CreateInstance(req)
+}
+
+func TestCreateInstanceWithRetry_AdoptsInstanceFromLostResponse(t *testing.T) {
+	createRetryInterval = time.Millisecond
+	defer func() { createRetryInterval = 5 * time.Second }()
+
+	client := &lostResponseClient{LetsCloudClient: NewLetsCloudClientMock(), failures: 1}
+
+	err := createInstanceWithRetry(context.Background(), client, &domains.CreateInstanceRequest{
+		Label:        "retry-instance",
+		Hostname:     "retry-instance.example.com",
+		LocationSlug: "us-east-1",
+		PlanSlug:     "plan-1",
+		ImageSlug:    "ubuntu-20-04",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 create call, got %d", client.calls)
+	}
+
+	instances, _ := client.Instances()
+	if len(instances) != 1 {
+		t.Errorf("expected 1 instance, got %d", len(instances))
+	}
+}
+
+func TestCreateInstanceWithRetry_RejectsMismatchedInstance(t *testing.T) {
+	createRetryInterval = time.Millisecond
+	defer func() { createRetryInterval = 5 * time.Second }()
+
+	mock := NewLetsCloudClientMock()
+	err := mock.CreateInstance(&domains.CreateInstanceRequest{
+		Label:        "retry-instance",
+		Hostname:     "other.example.com",
+		LocationSlug: "us-east-1",
+		PlanSlug:     "plan-1",
+		ImageSlug:    "ubuntu-20-04",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &failingCreateClient{LetsCloudClient: mock, failures: 1}
+
+	err = createInstanceWithRetry(context.Background(), client, &domains.CreateInstanceRequest{
+		Label:        "retry-instance",
+		Hostname:     "retry-instance.example.com",
+		LocationSlug: "us-east-1",
+		PlanSlug:     "plan-1",
+		ImageSlug:    "ubuntu-20-04",
+	})
+	if err == nil || !strings.Contains(err.Error(), "differs from the request in hostname") {
+		t.Fatalf("expected mismatch error, got %v", err)
+	}
+
+	if client.calls != 1 {
+		t.Errorf("expected 1 create call, got %d", client.calls)
+	}
+}
+
+func TestCreateInstanceWithRetry_RetriesWhenNothingWasCreated(t *testing.T) {
+	createRetryInterval = time.Millisecond
+	defer func() { createRetryInterval = 5 * time.Second }()
+
+	client := &failingCreateClient{LetsCloudClient: NewLetsCloudClientMock(), failures: 2}
+
+	err := createInstanceWithRetry(context.Background(), client, &domains.CreateInstanceRequest{
+		Label:        "retry-instance",
+		Hostname:     "retry-instance.example.com",
+		LocationSlug: "us-east-1",
+		PlanSlug:     "plan-1",
+		ImageSlug:    "ubuntu-20-04",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if client.calls != 3 {
+		t.Errorf("expected 3 create calls, got %d", client.calls)
+	}
+
+	instances, _ := client.Instances()
+	if len(instances) != 1 {
+		t.Errorf("expected 1 instance, got %d", len(instances))
+	}
+}
+
+func TestWaitForInstanceReady_ReturnsInstanceOnFailure(t *testing.T) {
+	mock := NewLetsCloudClientMock().(*letsCloudClientMock)
+	err := mock.CreateInstance(&domains.CreateInstanceRequest{
+		Label:    "suspended-instance",
+		Hostname: "suspended-instance.example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	mock.instances["mock-instance-1"].Suspended = true
+
+	instance, err := waitForInstanceReady(context.Background(), mock, "suspended-instance", "suspended-instance.example.com")
+	if err == nil {
+		t.Fatal("expected an error for a suspended instance")
+	}
+	if instance == nil || instance.Identifier != "mock-instance-1" {
+		t.Errorf("expected the suspended instance to be returned, got %+v", instance)
+	}
+}
+
+// testInstanceValues returns the attribute values of an instance created
+// from label_prefix, for protocol-level tests. The computed attributes are
+// included when state is true, and overrides replace individual values.
+func testInstanceValues(state bool, overrides ...map[string]tftypes.Value) map[string]tftypes.Value {
+	values := map[string]tftypes.Value{
+		"label_prefix":        tftypes.NewValue(tftypes.String, "web-"),
+		"hostname":            tftypes.NewValue(tftypes.String, "web.example.com"),
+		"location_slug":       tftypes.NewValue(tftypes.String, "us-east-1"),
+		"plan_slug":           tftypes.NewValue(tftypes.String, "plan-1"),
+		"image_slug":          tftypes.NewValue(tftypes.String, "ubuntu-20-04"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+		"adopt_existing":      tftypes.NewValue(tftypes.Bool, false),
+	}
+	if state {
+		values["id"] = tftypes.NewValue(tftypes.String, "mock-instance-1")
+		values["label"] = tftypes.NewValue(tftypes.String, "web-202601010000000001")
+		values["state"] = tftypes.NewValue(tftypes.String, "running")
+		values["ipv4"] = tftypes.NewValue(tftypes.String, "192.0.2.10")
+		values["ipv6"] = tftypes.NewValue(tftypes.String, "")
+	}
+
+	for _, override := range overrides {
+		for name, value := range override {
+			values[name] = value
+		}
+	}
+
+	return values
+}
+
 func testAccInstanceResourceConfig(name string) string {
 	return fmt.Sprintf(`
 provider "letscloud" {
@@ -69,3 +684,81 @@ resource "letscloud_instance" "test" {
 }
 `, name)
 }
+
+func testAccInstanceResourceConfigDeletionProtection(name string, protected bool) string {
+	return fmt.Sprintf(`
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "test" {
+  label               = %[1]q
+  hostname            = "%[1]s.example.com"
+  location_slug       = "us-east-1"
+  plan_slug           = "plan-1"
+  image_slug          = "ubuntu-20-04"
+  deletion_protection = %[2]t
+}
+`, name, protected)
+}
+
+func testAccInstanceResourceConfigTriggers(name, reboot, rebuild string) string {
+	return fmt.Sprintf(`
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "test" {
+  label         = %[1]q
+  hostname      = "%[1]s.example.com"
+  location_slug = "us-east-1"
+  plan_slug     = "plan-1"
+  image_slug    = "ubuntu-20-04"
+
+  reboot_triggers = {
+    config = %[2]q
+  }
+
+  rebuild_triggers = {
+    image = %[3]q
+  }
+}
+`, name, reboot, rebuild)
+}
+
+func testAccInstanceResourceConfigAdopt(name string) string {
+	return fmt.Sprintf(`
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "test" {
+  label          = %[1]q
+  hostname       = "%[1]s.example.com"
+  location_slug  = "us-east-1"
+  plan_slug      = "plan-1"
+  image_slug     = "ubuntu-20-04"
+  adopt_existing = true
+}
+`, name)
+}
+
+func testAccInstanceResourceConfigLabelPrefix(prefix string) string {
+	return fmt.Sprintf(`
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "test" {
+  label_prefix  = %[1]q
+  hostname      = "%[1]sserver.example.com"
+  location_slug = "us-east-1"
+  plan_slug     = "plan-1"
+  image_slug    = "ubuntu-20-04"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, prefix)
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/letscloud-community/letscloud-go/domains"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstancesDataSource{}
+
+func NewInstancesDataSource() datasource.DataSource {
+	return &InstancesDataSource{}
+}
+
+// InstancesDataSource defines the data source implementation.
+type InstancesDataSource struct {
+	client LetsCloudClient
+}
+
+// InstancesDataSourceModel describes the data source data model.
+type InstancesDataSourceModel struct {
+	Filters   []InstancesFilterModel `tfsdk:"filter"`
+	Sorts     []InstancesSortModel   `tfsdk:"sort"`
+	Instances []InstancesItemModel   `tfsdk:"instances"`
+}
+
+// InstancesFilterModel describes a filter block.
+type InstancesFilterModel struct {
+	Name   types.String   `tfsdk:"name"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// InstancesSortModel describes a sort block.
+type InstancesSortModel struct {
+	Key       types.String `tfsdk:"key"`
+	Direction types.String `tfsdk:"direction"`
+}
+
+// InstancesItemModel describes a single instance in the list.
+type InstancesItemModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Label        types.String   `tfsdk:"label"`
+	Hostname     types.String   `tfsdk:"hostname"`
+	LocationSlug types.String   `tfsdk:"location_slug"`
+	State        types.String   `tfsdk:"state"`
+	IPv4         types.String   `tfsdk:"ipv4"`
+	IPv6         types.String   `tfsdk:"ipv6"`
+	IPs          []types.String `tfsdk:"ips"`
+}
+
+// instanceFields maps the names usable in filter and sort blocks to the
+// instance value they refer to.
+var instanceFields = map[string]func(*domains.Instance) string{
+	"id":       func(i *domains.Instance) string { return i.Identifier },
+	"label":    func(i *domains.Instance) string { return i.Label },
+	"hostname": func(i *domains.Instance) string { return i.Hostname },
+	"location": func(i *domains.Instance) string { return i.Location.Slug },
+	"state":    getInstanceState,
+}
+
+// regexInstanceFields are the filter names whose values are regular expressions.
+var regexInstanceFields = map[string]bool{
+	"label":    true,
+	"hostname": true,
+}
+
+func (d *InstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instances"
+}
+
+func (d *InstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all instances in the account, optionally filtered and sorted. " +
+			"The LetsCloud API does not return instance tags, so instances cannot be filtered by tag.",
+
+		Attributes: map[string]schema.Attribute{
+			"instances": schema.ListNestedAttribute{
+				MarkdownDescription: "List of matching instances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the instance.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the instance.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "The hostname of the instance.",
+							Computed:            true,
+						},
+						"location_slug": schema.StringAttribute{
+							MarkdownDescription: "The location slug of the instance.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The current state of the instance.",
+							Computed:            true,
+						},
+						"ipv4": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address of the instance.",
+							Computed:            true,
+						},
+						"ipv6": schema.StringAttribute{
+							MarkdownDescription: "The IPv6 address of the instance.",
+							Computed:            true,
+						},
+						"ips": schema.ListAttribute{
+							MarkdownDescription: "All IP addresses of the instance.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.ListNestedBlock{
+				MarkdownDescription: "Only return instances matching this filter. An instance must match every filter block, " +
+					"and any of the values within a block.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The field to filter on: `location`, `state`, `label` or `hostname`. Filtering on `tags` is rejected because the API has no instance tags.",
+							Required:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The values to match. For `label` and `hostname` these are regular expressions.",
+							Required:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"sort": schema.ListNestedBlock{
+				MarkdownDescription: "Sort the instances by a field. Later blocks break ties left by earlier ones. " +
+					"Without sort blocks instances are sorted by label.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The field to sort by: `id`, `label`, `hostname`, `location` or `state`.",
+							Required:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "The sort direction, `asc` or `desc`. Defaults to `asc`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstancesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, err := newInstanceFilters(data.Filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Filter", err.Error())
+		return
+	}
+
+	sorts, err := newInstanceSorts(data.Sorts)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Sort", err.Error())
+		return
+	}
+
+	instances, err := d.client.Instances()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list instances, got error: %s", err))
+		return
+	}
+
+	matches := filterInstances(instances, filters)
+	sortInstances(matches, sorts)
+
+	// Map response to model
+	data.Instances = make([]InstancesItemModel, len(matches))
+	for i := range matches {
+		instance := &matches[i]
+
+		ips := make([]types.String, len(instance.IPAddresses))
+		for j, ip := range instance.IPAddresses {
+			ips[j] = types.StringValue(ip.Address)
+		}
+
+		data.Instances[i] = InstancesItemModel{
+			Id:           types.StringValue(instance.Identifier),
+			Label:        types.StringValue(instance.Label),
+			Hostname:     types.StringValue(instance.Hostname),
+			LocationSlug: types.StringValue(instance.Location.Slug),
+			State:        types.StringValue(getInstanceState(instance)),
+			IPv4:         types.StringValue(getInstanceIPv4(instance)),
+			IPv6:         types.StringValue(getInstanceIPv6(instance)),
+			IPs:          ips,
+		}
+	}
+
+	tflog.Info(ctx, "Instances data source read successfully", map[string]interface{}{
+		"total":    len(instances),
+		"matching": len(matches),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// instanceFilter matches instances whose field equals, or for regex fields
+// matches, any of the values.
+type instanceFilter struct {
+	field   string
+	values  []string
+	regexes []*regexp.Regexp
+}
+
+func newInstanceFilters(models []InstancesFilterModel) ([]instanceFilter, error) {
+	filters := make([]instanceFilter, 0, len(models))
+	for _, model := range models {
+		name := model.Name.ValueString()
+		if name == "tags" {
+			return nil, fmt.Errorf("filtering by tags is not supported, the LetsCloud API does not return instance tags")
+		}
+		if _, ok := instanceFields[name]; !ok || name == "id" {
+			return nil, fmt.Errorf("unsupported filter name %q, expected one of location, state, label or hostname", name)
+		}
+
+		filter := instanceFilter{field: name}
+		for _, value := range model.Values {
+			if !regexInstanceFields[name] {
+				filter.values = append(filter.values, value.ValueString())
+				continue
+			}
+
+			re, err := regexp.Compile(value.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s filter regular expression %q: %w", name, value.ValueString(), err)
+			}
+			filter.regexes = append(filter.regexes, re)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func (f instanceFilter) matches(instance *domains.Instance) bool {
+	value := instanceFields[f.field](instance)
+	for _, v := range f.values {
+		if v == value {
+			return true
+		}
+	}
+	for _, re := range f.regexes {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterInstances returns the instances matching every filter.
+func filterInstances(instances []domains.Instance, filters []instanceFilter) []domains.Instance {
+	matches := make([]domains.Instance, 0, len(instances))
+	for i := range instances {
+		matched := true
+		for _, filter := range filters {
+			if !filter.matches(&instances[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, instances[i])
+		}
+	}
+	return matches
+}
+
+// instanceSort orders instances by a field.
+type instanceSort struct {
+	field      string
+	descending bool
+}
+
+func newInstanceSorts(models []InstancesSortModel) ([]instanceSort, error) {
+	sorts := make([]instanceSort, 0, len(models)+1)
+	for _, model := range models {
+		key := model.Key.ValueString()
+		if _, ok := instanceFields[key]; !ok {
+			return nil, fmt.Errorf("unsupported sort key %q, expected one of id, label, hostname, location or state", key)
+		}
+
+		direction := strings.ToLower(model.Direction.ValueString())
+		if direction != "" && direction != "asc" && direction != "desc" {
+			return nil, fmt.Errorf("unsupported sort direction %q, expected asc or desc", model.Direction.ValueString())
+		}
+
+		sorts = append(sorts, instanceSort{field: key, descending: direction == "desc"})
+	}
+
+	// The API returns instances in no particular order, so always end on a
+	// unique key to keep the result stable between runs.
+	if len(sorts) == 0 {
+		sorts = append(sorts, instanceSort{field: "label"})
+	}
+	sorts = append(sorts, instanceSort{field: "id"})
+
+	return sorts, nil
+}
+
+// sortInstances sorts instances in place by the given sorts, in order.
+func sortInstances(instances []domains.Instance, sorts []instanceSort) {
+	sort.SliceStable(instances, func(i, j int) bool {
+		for _, s := range sorts {
+			a := instanceFields[s.field](&instances[i])
+			b := instanceFields[s.field](&instances[j])
+			if a == b {
+				continue
+			}
+			if s.descending {
+				return a > b
+			}
+			return a < b
+		}
+		return false
+	})
+}
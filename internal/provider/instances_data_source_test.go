@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+func TestAccInstancesDataSource(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test")
+	}
+
+	MockLetsCloudClient = NewLetsCloudClientMock()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+resource "letscloud_instance" "web" {
+  count = 2
+
+  label         = "web-${count.index + 1}"
+  hostname      = "web-${count.index + 1}.example.com"
+  location_slug = "us-east-1"
+  plan_slug     = "plan-1"
+  image_slug    = "ubuntu-20-04"
+}
+
+resource "letscloud_instance" "db" {
+  label         = "db-1"
+  hostname      = "db-1.example.com"
+  location_slug = "br-sp-1"
+  plan_slug     = "plan-1"
+  image_slug    = "ubuntu-20-04"
+}
+
+data "letscloud_instances" "web" {
+  filter {
+    name   = "label"
+    values = ["^web-"]
+  }
+
+  filter {
+    name   = "location"
+    values = ["us-east-1"]
+  }
+
+  sort {
+    key       = "label"
+    direction = "desc"
+  }
+
+  depends_on = [letscloud_instance.web, letscloud_instance.db]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_instances.web", "instances.#", "2"),
+					resource.TestCheckResourceAttr("data.letscloud_instances.web", "instances.0.label", "web-2"),
+					resource.TestCheckResourceAttr("data.letscloud_instances.web", "instances.1.label", "web-1"),
+					resource.TestCheckResourceAttr("data.letscloud_instances.web", "instances.0.ips.#", "2"),
+				),
+			},
+			{
+				Config: `
+provider "letscloud" {
+  api_token = "mock-token-for-testing"
+}
+
+data "letscloud_instances" "invalid" {
+  filter {
+    name   = "tags"
+    values = ["web"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`filtering by tags is not supported`),
+			},
+		},
+	})
+}
+
+func TestFilterAndSortInstances(t *testing.T) {
+	instances := []domains.Instance{
+		{Identifier: "3", Label: "web-b", Hostname: "b.example.com", Location: domains.Location{Slug: "us-east-1"}, Built: true, Booted: true},
+		{Identifier: "1", Label: "web-a", Hostname: "a.example.com", Location: domains.Location{Slug: "br-sp-1"}, Built: true, Booted: true},
+		{Identifier: "2", Label: "db", Hostname: "db.internal", Location: domains.Location{Slug: "us-east-1"}, Built: true},
+		{Identifier: "4", Label: "web-c", Hostname: "c.example.com", Location: domains.Location{Slug: "us-east-1"}, Suspended: true},
+	}
+
+	filters, err := newInstanceFilters([]InstancesFilterModel{
+		{Name: types.StringValue("hostname"), Values: []types.String{types.StringValue(`\.example\.com$`)}},
+		{Name: types.StringValue("state"), Values: []types.String{types.StringValue("running"), types.StringValue("suspended")}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sorts, err := newInstanceSorts([]InstancesSortModel{
+		{Key: types.StringValue("location"), Direction: types.StringValue("desc")},
+		{Key: types.StringValue("label"), Direction: types.StringNull()},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	matches := filterInstances(instances, filters)
+	sortInstances(matches, sorts)
+
+	want := []string{"3", "4", "1"}
+	if len(matches) != len(want) {
+		t.Fatalf("expected %d instances, got %d", len(want), len(matches))
+	}
+	for i, instance := range matches {
+		if instance.Identifier != want[i] {
+			t.Errorf("expected instance %s at position %d, got %s", want[i], i, instance.Identifier)
+		}
+	}
+
+	if _, err := newInstanceFilters([]InstancesFilterModel{{Name: types.StringValue("label"), Values: []types.String{types.StringValue("(")}}}); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+	if _, err := newInstanceFilters([]InstancesFilterModel{{Name: types.StringValue("tags"), Values: []types.String{types.StringValue("web")}}}); err == nil || !strings.Contains(err.Error(), "does not return instance tags") {
+		t.Errorf("expected a tags filter error, got %v", err)
+	}
+	if _, err := newInstanceSorts([]InstancesSortModel{{Key: types.StringValue("label"), Direction: types.StringValue("up")}}); err == nil {
+		t.Error("expected an error for an invalid sort direction")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package labelprefix generates unique labels from a user supplied prefix,
+// for resources whose labels must be unique within the account.
+package labelprefix
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SuffixLength is the number of characters Generate appends to the prefix.
+const SuffixLength = 18
+
+var (
+	mu      sync.Mutex
+	last    string
+	counter int
+)
+
+// Generate returns prefix followed by a suffix built from the current UTC
+// time and a counter, so labels generated by one provider process never
+// repeat and sort in creation order.
+func Generate(prefix string) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	timestamp := time.Now().UTC().Format("20060102150405")
+	if timestamp != last {
+		last = timestamp
+		counter = 0
+	}
+	counter++
+
+	return fmt.Sprintf("%s%s%04d", prefix, timestamp, counter%10000)
+}
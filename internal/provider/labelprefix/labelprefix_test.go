@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package labelprefix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	seen := make(map[string]bool)
+
+	for i := 0; i < 1000; i++ {
+		label := Generate("web-")
+
+		if !strings.HasPrefix(label, "web-") {
+			t.Fatalf("expected label to start with the prefix, got %q", label)
+		}
+		if len(label) != len("web-")+SuffixLength {
+			t.Fatalf("expected a %d character suffix, got %q", SuffixLength, label)
+		}
+		if seen[label] {
+			t.Fatalf("label %q generated twice", label)
+		}
+		seen[label] = true
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/letscloud-community/letscloud-go"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/catalog"
 	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
 	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/sshkey"
 )
@@ -113,6 +114,7 @@ func (p *LetsCloudProvider) Configure(ctx context.Context, req provider.Configur
 		p.client = MockLetsCloudClient
 		resp.DataSourceData = p.client
 		resp.ResourceData = p.client
+		resp.EphemeralResourceData = p.client
 		return
 	}
 
@@ -169,35 +171,49 @@ func (p *LetsCloudProvider) Configure(ctx context.Context, req provider.Configur
 	// Store the client in the provider
 	p.client = client
 
-	// Make the LetsCloud client available during DataSource and Resource
-	// type Configure methods.
+	// Make the LetsCloud client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = p.client
 	resp.ResourceData = p.client
+	resp.EphemeralResourceData = p.client
 }
 
 func (p *LetsCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewInstanceResource,
 		sshkey.NewSSHKeyResource,
+		sshkey.NewSSHKeySetResource,
 	}
 }
 
 func (p *LetsCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
-		// Add your ephemeral resources here
+		sshkey.NewSSHKeyPairEphemeralResource,
 	}
 }
 
 func (p *LetsCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccountDataSource,
+		NewInstanceDataSource,
+		NewInstancesDataSource,
 		sshkey.NewSSHKeyDataSource,
 		sshkey.NewSSHKeysDataSource,
+		sshkey.NewSSHKeyIdsDataSource,
+		sshkey.NewRemoteSSHKeysDataSource,
+		catalog.NewLocationsDataSource,
+		catalog.NewPlansDataSource,
+		catalog.NewPlanDataSource,
+		catalog.NewImagesDataSource,
+		catalog.NewImageDataSource,
 	}
 }
 
 func (p *LetsCloudProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// Add your functions here
+		sshkey.NewSSHKeyFingerprintFunction,
+		sshkey.NewParseSSHKeyFunction,
+		sshkey.NewParseAuthorizedKeysFunction,
 	}
 }
 
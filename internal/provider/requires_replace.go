@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// replacingAttributes returns the top-level attributes of s whose plan
+// modifiers require replacing the resource. The framework only merges the
+// attribute-level RequiresReplace into the plan after the resource-level
+// ModifyPlan, so ModifyPlan runs the modifiers again to see a replacement.
+// Only string, bool, list and map attributes are checked.
+func replacingAttributes(ctx context.Context, s schema.Schema, req resource.ModifyPlanRequest) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var replacing []string
+
+	names := make([]string, 0, len(s.Attributes))
+	for name := range s.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var replace bool
+		var d diag.Diagnostics
+
+		switch attribute := s.Attributes[name].(type) {
+		case schema.StringAttribute:
+			replace, d = stringRequiresReplace(ctx, path.Root(name), attribute.PlanModifiers, req)
+		case schema.BoolAttribute:
+			replace, d = boolRequiresReplace(ctx, path.Root(name), attribute.PlanModifiers, req)
+		case schema.ListAttribute:
+			replace, d = listRequiresReplace(ctx, path.Root(name), attribute.PlanModifiers, req)
+		case schema.MapAttribute:
+			replace, d = mapRequiresReplace(ctx, path.Root(name), attribute.PlanModifiers, req)
+		}
+
+		diags.Append(d...)
+		if replace {
+			replacing = append(replacing, name)
+		}
+	}
+
+	return replacing, diags
+}
+
+func stringRequiresReplace(ctx context.Context, p path.Path, modifiers []planmodifier.String, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(modifiers) == 0 {
+		return false, diags
+	}
+
+	modifierReq := planmodifier.StringRequest{
+		Path:           p,
+		PathExpression: p.Expression(),
+		Config:         req.Config,
+		Plan:           req.Plan,
+		State:          req.State,
+		Private:        req.Private,
+	}
+	diags.Append(req.Config.GetAttribute(ctx, p, &modifierReq.ConfigValue)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, &modifierReq.PlanValue)...)
+	diags.Append(req.State.GetAttribute(ctx, p, &modifierReq.StateValue)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, modifier := range modifiers {
+		modifierResp := &planmodifier.StringResponse{PlanValue: modifierReq.PlanValue}
+		modifier.PlanModifyString(ctx, modifierReq, modifierResp)
+		diags.Append(modifierResp.Diagnostics...)
+		if modifierResp.RequiresReplace {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+func boolRequiresReplace(ctx context.Context, p path.Path, modifiers []planmodifier.Bool, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(modifiers) == 0 {
+		return false, diags
+	}
+
+	modifierReq := planmodifier.BoolRequest{
+		Path:           p,
+		PathExpression: p.Expression(),
+		Config:         req.Config,
+		Plan:           req.Plan,
+		State:          req.State,
+		Private:        req.Private,
+	}
+	diags.Append(req.Config.GetAttribute(ctx, p, &modifierReq.ConfigValue)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, &modifierReq.PlanValue)...)
+	diags.Append(req.State.GetAttribute(ctx, p, &modifierReq.StateValue)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, modifier := range modifiers {
+		modifierResp := &planmodifier.BoolResponse{PlanValue: modifierReq.PlanValue}
+		modifier.PlanModifyBool(ctx, modifierReq, modifierResp)
+		diags.Append(modifierResp.Diagnostics...)
+		if modifierResp.RequiresReplace {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+func listRequiresReplace(ctx context.Context, p path.Path, modifiers []planmodifier.List, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(modifiers) == 0 {
+		return false, diags
+	}
+
+	modifierReq := planmodifier.ListRequest{
+		Path:           p,
+		PathExpression: p.Expression(),
+		Config:         req.Config,
+		Plan:           req.Plan,
+		State:          req.State,
+		Private:        req.Private,
+	}
+	diags.Append(req.Config.GetAttribute(ctx, p, &modifierReq.ConfigValue)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, &modifierReq.PlanValue)...)
+	diags.Append(req.State.GetAttribute(ctx, p, &modifierReq.StateValue)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, modifier := range modifiers {
+		modifierResp := &planmodifier.ListResponse{PlanValue: modifierReq.PlanValue}
+		modifier.PlanModifyList(ctx, modifierReq, modifierResp)
+		diags.Append(modifierResp.Diagnostics...)
+		if modifierResp.RequiresReplace {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+func mapRequiresReplace(ctx context.Context, p path.Path, modifiers []planmodifier.Map, req resource.ModifyPlanRequest) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(modifiers) == 0 {
+		return false, diags
+	}
+
+	modifierReq := planmodifier.MapRequest{
+		Path:           p,
+		PathExpression: p.Expression(),
+		Config:         req.Config,
+		Plan:           req.Plan,
+		State:          req.State,
+		Private:        req.Private,
+	}
+	diags.Append(req.Config.GetAttribute(ctx, p, &modifierReq.ConfigValue)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, &modifierReq.PlanValue)...)
+	diags.Append(req.State.GetAttribute(ctx, p, &modifierReq.StateValue)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, modifier := range modifiers {
+		modifierResp := &planmodifier.MapResponse{PlanValue: modifierReq.PlanValue}
+		modifier.PlanModifyMap(ctx, modifierReq, modifierResp)
+		diags.Append(modifierResp.Diagnostics...)
+		if modifierResp.RequiresReplace {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReplacingAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+
+	value := func(region, name, trigger string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"region": tftypes.NewValue(tftypes.String, region),
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"triggers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"trigger": tftypes.NewValue(tftypes.String, trigger),
+			}),
+		})
+	}
+
+	tests := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected []string
+	}{
+		"no change": {
+			state: value("us-east-1", "web", "1"),
+			plan:  value("us-east-1", "web", "1"),
+		},
+		"in-place change": {
+			state: value("us-east-1", "web", "1"),
+			plan:  value("us-east-1", "db", "1"),
+		},
+		"replacing changes": {
+			state:    value("us-east-1", "web", "1"),
+			plan:     value("br-sp-1", "web", "2"),
+			expected: []string{"region", "triggers"},
+		},
+		"create": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value("br-sp-1", "web", "1"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: test.plan},
+				Plan:   tfsdk.Plan{Schema: s, Raw: test.plan},
+				State:  tfsdk.State{Schema: s, Raw: test.state},
+			}
+
+			replacing, diags := replacingAttributes(ctx, s, req)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if strings.Join(replacing, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected %v, got %v", test.expected, replacing)
+			}
+		})
+	}
+}
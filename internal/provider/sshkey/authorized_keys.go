@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"fmt"
+	"strings"
+)
+
+// authorizedKeysEntry is a key parsed from one line of an authorized_keys file.
+type authorizedKeysEntry struct {
+	Line int
+	Key  *keyInfo
+}
+
+// authorizedKeysError reports a line of an authorized_keys file that is not
+// a valid public key.
+type authorizedKeysError struct {
+	Line int
+	Err  error
+}
+
+func (e *authorizedKeysError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *authorizedKeysError) Unwrap() error {
+	return e.Err
+}
+
+// parseAuthorizedKeys parses the content of an authorized_keys file. Blank
+// lines and lines starting with # are skipped. Lines that do not parse are
+// returned as errors alongside the keys that do, so callers can decide
+// whether to fail or skip them.
+func parseAuthorizedKeys(content string) ([]authorizedKeysEntry, []*authorizedKeysError) {
+	var entries []authorizedKeysEntry
+	var errs []*authorizedKeysError
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		info, err := parseKeyInfo(line)
+		if err != nil {
+			errs = append(errs, &authorizedKeysError{Line: i + 1, Err: err})
+			continue
+		}
+
+		entries = append(entries, authorizedKeysEntry{Line: i + 1, Key: info})
+	}
+
+	return entries, errs
+}
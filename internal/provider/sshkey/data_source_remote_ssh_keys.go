@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RemoteSSHKeysDataSource{}
+
+const (
+	// remoteSSHKeysTimeout bounds the request for the key list.
+	remoteSSHKeysTimeout = 30 * time.Second
+
+	// maxRemoteSSHKeysSize is the largest key list that is read, which is far
+	// more than any real authorized_keys file.
+	maxRemoteSSHKeysSize = 1 << 20
+)
+
+func NewRemoteSSHKeysDataSource() datasource.DataSource {
+	return &RemoteSSHKeysDataSource{
+		httpClient: &http.Client{Timeout: remoteSSHKeysTimeout},
+	}
+}
+
+// RemoteSSHKeysDataSource defines the data source implementation. It does
+// not use the LetsCloud API, only the HTTP client.
+type RemoteSSHKeysDataSource struct {
+	httpClient *http.Client
+}
+
+// RemoteSSHKeysDataSourceModel describes the data source data model.
+type RemoteSSHKeysDataSourceModel struct {
+	URL                types.String        `tfsdk:"url"`
+	PinnedFingerprints []types.String      `tfsdk:"pinned_fingerprints"`
+	Id                 types.String        `tfsdk:"id"`
+	Keys               []RemoteSSHKeyModel `tfsdk:"keys"`
+	PublicKeys         []types.String      `tfsdk:"public_keys"`
+}
+
+// RemoteSSHKeyModel describes a single key fetched from the URL.
+type RemoteSSHKeyModel struct {
+	PublicKey         types.String `tfsdk:"public_key"`
+	KeyType           types.String `tfsdk:"key_type"`
+	Bits              types.Int64  `tfsdk:"bits"`
+	Comment           types.String `tfsdk:"comment"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	FingerprintMD5    types.String `tfsdk:"fingerprint_md5"`
+}
+
+func (d *RemoteSSHKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_ssh_keys"
+}
+
+func (d *RemoteSSHKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches SSH public keys in authorized_keys format from a URL, such as `https://github.com/<user>.keys`. " +
+			"Every key is validated like the `key` of `letscloud_ssh_key`.",
+
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The `http` or `https` URL serving the keys, one per line.",
+				Required:            true,
+			},
+			"pinned_fingerprints": schema.ListAttribute{
+				MarkdownDescription: "When set, every fetched key must have one of these fingerprints, so a changed key list fails the read instead of granting access. " +
+					"Both the `SHA256:` and the `MD5:` forms are accepted.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The URL the keys were fetched from.",
+				Computed:            true,
+			},
+			"public_keys": schema.ListAttribute{
+				MarkdownDescription: "The fetched public keys in authorized_keys format, in the order of the URL.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The fetched keys, in the order of the URL.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The public key in authorized_keys format.",
+							Computed:            true,
+						},
+						"key_type": schema.StringAttribute{
+							MarkdownDescription: "The key algorithm, e.g. `ssh-ed25519` or `ssh-rsa`.",
+							Computed:            true,
+						},
+						"bits": schema.Int64Attribute{
+							MarkdownDescription: "The key size in bits.",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "The comment at the end of the key. Empty if the key has none.",
+							Computed:            true,
+						},
+						"fingerprint_sha256": schema.StringAttribute{
+							MarkdownDescription: "The SHA256 fingerprint of the key, as printed by `ssh-keygen -l`.",
+							Computed:            true,
+						},
+						"fingerprint_md5": schema.StringAttribute{
+							MarkdownDescription: "The legacy MD5 fingerprint of the key, prefixed with `MD5:`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RemoteSSHKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RemoteSSHKeysDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := fetchRemoteSSHKeys(ctx, d.httpClient, data.URL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Unable to Fetch SSH Keys",
+			fmt.Sprintf("Unable to fetch SSH keys from %s, got error: %s", data.URL.ValueString(), err),
+		)
+		return
+	}
+
+	pins := make([]string, 0, len(data.PinnedFingerprints))
+	for _, pin := range data.PinnedFingerprints {
+		pins = append(pins, pin.ValueString())
+	}
+
+	keys, diags := parseRemoteSSHKeys(content, data.PinnedFingerprints != nil, pins)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response to model
+	data.Id = data.URL
+	data.Keys = make([]RemoteSSHKeyModel, 0, len(keys))
+	data.PublicKeys = make([]types.String, 0, len(keys))
+	for _, info := range keys {
+		publicKey := info.authorizedKey()
+		if info.Comment != "" {
+			publicKey += " " + info.Comment
+		}
+
+		data.Keys = append(data.Keys, RemoteSSHKeyModel{
+			PublicKey:         types.StringValue(publicKey),
+			KeyType:           types.StringValue(info.Type),
+			Bits:              types.Int64Value(int64(info.Bits)),
+			Comment:           types.StringValue(info.Comment),
+			FingerprintSHA256: types.StringValue(info.FingerprintSHA256),
+			FingerprintMD5:    types.StringValue(info.FingerprintMD5),
+		})
+		data.PublicKeys = append(data.PublicKeys, types.StringValue(publicKey))
+	}
+
+	tflog.Info(ctx, "Remote SSH keys data source read successfully", map[string]interface{}{
+		"url":   data.URL.ValueString(),
+		"count": len(data.Keys),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchRemoteSSHKeys returns the body of rawURL, which must be an http or
+// https URL answering 200 OK.
+func fetchRemoteSSHKeys(ctx context.Context, httpClient *http.Client, rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("unsupported URL scheme %q, expected http or https", u.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response status %s", httpResp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxRemoteSSHKeysSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxRemoteSSHKeysSize {
+		return "", fmt.Errorf("response is larger than %d bytes", maxRemoteSSHKeysSize)
+	}
+
+	return string(body), nil
+}
+
+// parseRemoteSSHKeys parses and validates the fetched keys. When pinned is
+// true, every key must match one of pins.
+func parseRemoteSSHKeys(content string, pinned bool, pins []string) ([]*keyInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	entries, errs := parseAuthorizedKeys(content)
+	for _, err := range errs {
+		diags.AddAttributeError(path.Root("url"), "Invalid SSH key format", err.Error())
+	}
+
+	keys := make([]*keyInfo, 0, len(entries))
+	used := make([]bool, len(pins))
+	for _, entry := range entries {
+		if err := entry.Key.checkSupported(); err != nil {
+			diags.AddAttributeError(path.Root("url"), "Invalid SSH key format", fmt.Sprintf("line %d: %s", entry.Line, err))
+			continue
+		}
+
+		if pinned {
+			match := false
+			for i, pin := range pins {
+				if entry.Key.matchesFingerprint(pin) {
+					used[i] = true
+					match = true
+				}
+			}
+
+			if !match {
+				diags.AddAttributeError(
+					path.Root("pinned_fingerprints"),
+					"Unpinned SSH Key",
+					fmt.Sprintf("line %d: the key %s is not in pinned_fingerprints.", entry.Line, entry.Key.FingerprintSHA256),
+				)
+				continue
+			}
+		}
+
+		keys = append(keys, entry.Key)
+	}
+
+	// An unused pin next to an unpinned key is usually the same rotated key,
+	// which the error already reports.
+	if diags.HasError() {
+		return keys, diags
+	}
+
+	for i, pin := range pins {
+		if !used[i] {
+			diags.AddAttributeWarning(
+				path.Root("pinned_fingerprints").AtListIndex(i),
+				"Pinned SSH Key Not Found",
+				fmt.Sprintf("No fetched key has the fingerprint %s.", pin),
+			)
+		}
+	}
+
+	return keys, diags
+}
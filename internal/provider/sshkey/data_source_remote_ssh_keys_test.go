@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchRemoteSSHKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/alice.keys":
+			fmt.Fprintln(w, testKeyEd25519)
+			fmt.Fprintln(w, testKeyRSA)
+		case "/large.keys":
+			fmt.Fprint(w, strings.Repeat("#", maxRemoteSSHKeysSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	content, err := fetchRemoteSSHKeys(ctx, server.Client(), server.URL+"/alice.keys")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if content != testKeyEd25519+"\n"+testKeyRSA+"\n" {
+		t.Errorf("unexpected content %q", content)
+	}
+
+	for _, rawURL := range []string{
+		server.URL + "/missing.keys",
+		server.URL + "/large.keys",
+		"file:///etc/passwd",
+	} {
+		if _, err := fetchRemoteSSHKeys(ctx, server.Client(), rawURL); err == nil {
+			t.Errorf("expected error for %s", rawURL)
+		}
+	}
+}
+
+func TestParseRemoteSSHKeys(t *testing.T) {
+	content := testKeyEd25519 + "\n" + testKeyRSA + "\n"
+
+	keys, diags := parseRemoteSSHKeys(content, false, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(keys) != 2 || keys[0].Comment != "alice@example.com" || keys[1].Comment != "bob@example.com" {
+		t.Errorf("unexpected keys %v", keys)
+	}
+
+	// Pins accept both fingerprint forms
+	keys, diags = parseRemoteSSHKeys(content, true, []string{
+		"SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A",
+		"0b:0f:98:d8:34:15:1e:52:78:57:2e:9a:3e:ac:9e:82",
+	})
+	if diags.HasError() || diags.WarningsCount() != 0 || len(keys) != 2 {
+		t.Errorf("expected both keys to be pinned, got %d keys and %v", len(keys), diags)
+	}
+
+	_, diags = parseRemoteSSHKeys(content, true, []string{"SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "line 2") {
+		t.Errorf("expected unpinned key error for line 2, got %v", diags)
+	}
+
+	// Unused pins are only warned about when there is no error
+	unusedPin := "SHA256:pGsFfaKYNRZXZkfe7H/C8wgxS8clzMaIpS6gigFEKc8"
+	_, diags = parseRemoteSSHKeys(content, true, []string{
+		"SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A",
+		"SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I",
+		unusedPin,
+	})
+	if diags.HasError() || diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), unusedPin) {
+		t.Errorf("expected a warning for the unused pin, got %v", diags)
+	}
+
+	_, diags = parseRemoteSSHKeys(content, true, []string{"SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A", unusedPin})
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Errorf("expected only the unpinned key error, got %v", diags)
+	}
+
+	// An empty pin list allows no keys at all
+	_, diags = parseRemoteSSHKeys(content, true, nil)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected 2 errors, got %v", diags)
+	}
+
+	_, diags = parseRemoteSSHKeys(testKeyEd25519+"\n"+testKeyRSA1024+"\n", false, nil)
+	if !diags.HasError() {
+		t.Error("expected error for weak RSA key")
+	}
+}
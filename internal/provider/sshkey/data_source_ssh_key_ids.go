@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/letscloud-go/domains"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SSHKeyIdsDataSource{}
+
+func NewSSHKeyIdsDataSource() datasource.DataSource {
+	return &SSHKeyIdsDataSource{}
+}
+
+// SSHKeyIdsDataSource defines the data source implementation.
+type SSHKeyIdsDataSource struct {
+	client client.LetsCloudClient
+}
+
+// SSHKeyIdsDataSourceModel describes the data source data model.
+type SSHKeyIdsDataSourceModel struct {
+	Labels        []types.String          `tfsdk:"labels"`
+	Strict        types.Bool              `tfsdk:"strict"`
+	Ids           []types.String          `tfsdk:"ids"`
+	IdsByLabel    map[string]types.String `tfsdk:"ids_by_label"`
+	MissingLabels []types.String          `tfsdk:"missing_labels"`
+}
+
+func (d *SSHKeyIdsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_ids"
+}
+
+func (d *SSHKeyIdsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a list of SSH key labels to their IDs with a single API call.",
+
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the SSH keys to resolve.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "Whether a label without a matching SSH key is an error. When `false`, missing labels are skipped with a warning. Defaults to `true`.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the SSH keys, in the order of `labels`. Repeated labels are only resolved once.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ids_by_label": schema.MapAttribute{
+				MarkdownDescription: "Map of label to SSH key ID for every label that was found.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"missing_labels": schema.ListAttribute{
+				MarkdownDescription: "The labels that did not match any SSH key. Always empty when `strict` is `true`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SSHKeyIdsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SSHKeyIdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSHKeyIdsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make([]string, 0, len(data.Labels))
+	for _, label := range data.Labels {
+		labels = append(labels, label.ValueString())
+	}
+
+	// Fetch all SSH keys once and resolve every label against the result
+	sshKeys, err := d.client.SSHKeys()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list SSH keys, got error: %s", err))
+		return
+	}
+
+	ids, missing := resolveSSHKeyLabels(sshKeys, labels)
+
+	if len(missing) > 0 {
+		detail := fmt.Sprintf("No SSH key found with label(s): '%s'", strings.Join(missing, "', '"))
+
+		if data.Strict.IsNull() || data.Strict.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("labels"), "SSH Key Not Found", detail)
+			return
+		}
+
+		resp.Diagnostics.AddAttributeWarning(path.Root("labels"), "SSH Key Not Found", detail+". The missing labels were skipped.")
+	}
+
+	// Map response to model
+	data.Ids = []types.String{}
+	data.IdsByLabel = map[string]types.String{}
+	for _, label := range labels {
+		id, ok := ids[label]
+		if !ok {
+			continue
+		}
+		if _, seen := data.IdsByLabel[label]; seen {
+			continue
+		}
+
+		data.Ids = append(data.Ids, types.StringValue(id))
+		data.IdsByLabel[label] = types.StringValue(id)
+	}
+
+	data.MissingLabels = make([]types.String, len(missing))
+	for i, label := range missing {
+		data.MissingLabels[i] = types.StringValue(label)
+	}
+
+	tflog.Info(ctx, "SSH key IDs data source read successfully", map[string]interface{}{
+		"found":   len(data.Ids),
+		"missing": len(missing),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveSSHKeyLabels maps each label to the ID of the first SSH key with
+// that label and returns the labels without a match, in order and without
+// repeats.
+func resolveSSHKeyLabels(sshKeys []domains.SSHKey, labels []string) (map[string]string, []string) {
+	byLabel := make(map[string]string, len(sshKeys))
+	for _, key := range sshKeys {
+		if _, exists := byLabel[key.Title]; !exists {
+			byLabel[key.Title] = key.Slug
+		}
+	}
+
+	ids := make(map[string]string, len(labels))
+	missing := []string{}
+	for _, label := range labels {
+		if _, done := ids[label]; done {
+			continue
+		}
+
+		id, ok := byLabel[label]
+		if !ok {
+			if !slices.Contains(missing, label) {
+				missing = append(missing, label)
+			}
+			continue
+		}
+
+		ids[label] = id
+	}
+
+	return ids, missing
+}
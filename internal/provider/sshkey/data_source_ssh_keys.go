@@ -6,11 +6,15 @@ package sshkey
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/letscloud-go/domains"
 	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
 )
 
@@ -28,7 +32,21 @@ type SSHKeysDataSource struct {
 
 // SSHKeysDataSourceModel describes the data source data model for multiple SSH keys.
 type SSHKeysDataSourceModel struct {
-	SSHKeys []SSHKeyDataSourceModel `tfsdk:"ssh_keys"`
+	LabelRegex  types.String          `tfsdk:"label_regex"`
+	LabelPrefix types.String          `tfsdk:"label_prefix"`
+	Fingerprint types.String          `tfsdk:"fingerprint"`
+	SSHKeys     []SSHKeysElementModel `tfsdk:"ssh_keys"`
+}
+
+// SSHKeysElementModel describes a single SSH key in the list.
+type SSHKeysElementModel struct {
+	Id                types.String `tfsdk:"id"`
+	Label             types.String `tfsdk:"label"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	KeyType           types.String `tfsdk:"key_type"`
+	Bits              types.Int64  `tfsdk:"bits"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	FingerprintMD5    types.String `tfsdk:"fingerprint_md5"`
 }
 
 func (d *SSHKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,11 +55,23 @@ func (d *SSHKeysDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *SSHKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches information about all SSH keys in the account.",
+		MarkdownDescription: "Fetches information about the SSH keys in the account, optionally filtered by label or fingerprint.",
 
 		Attributes: map[string]schema.Attribute{
+			"label_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return SSH keys whose label matches this regular expression.",
+				Optional:            true,
+			},
+			"label_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return SSH keys whose label starts with this prefix.",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Only return the SSH key with this fingerprint. Both the `SHA256:` and the `MD5:` forms are accepted.",
+				Optional:            true,
+			},
 			"ssh_keys": schema.ListNestedAttribute{
-				MarkdownDescription: "List of SSH keys in the account.",
+				MarkdownDescription: "List of SSH keys in the account matching the filters.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -53,6 +83,26 @@ func (d *SSHKeysDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "The label of the SSH key.",
 							Computed:            true,
 						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The public key in authorized_keys format.",
+							Computed:            true,
+						},
+						"key_type": schema.StringAttribute{
+							MarkdownDescription: "The key algorithm, e.g. `ssh-ed25519` or `ssh-rsa`. Null if the public key cannot be parsed.",
+							Computed:            true,
+						},
+						"bits": schema.Int64Attribute{
+							MarkdownDescription: "The key size in bits. Null if the public key cannot be parsed.",
+							Computed:            true,
+						},
+						"fingerprint_sha256": schema.StringAttribute{
+							MarkdownDescription: "The SHA256 fingerprint of the key, as printed by `ssh-keygen -l`. Null if the public key cannot be parsed.",
+							Computed:            true,
+						},
+						"fingerprint_md5": schema.StringAttribute{
+							MarkdownDescription: "The legacy MD5 fingerprint of the key, prefixed with `MD5:`. Null if the public key cannot be parsed.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -90,6 +140,12 @@ func (d *SSHKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	filter, err := newSSHKeysFilter(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("label_regex"), "Invalid Filter", err.Error())
+		return
+	}
+
 	// Fetch all SSH keys
 	sshKeys, err := d.client.SSHKeys()
 	if err != nil {
@@ -98,18 +154,94 @@ func (d *SSHKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Map response to model
-	data.SSHKeys = make([]SSHKeyDataSourceModel, len(sshKeys))
-	for i, key := range sshKeys {
-		data.SSHKeys[i] = SSHKeyDataSourceModel{
-			Id:    types.StringValue(key.Slug),
-			Label: types.StringValue(key.Title),
+	data.SSHKeys = make([]SSHKeysElementModel, 0, len(sshKeys))
+	for _, key := range sshKeys {
+		info, err := parseKeyInfo(key.PublicKey)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to parse SSH public key", map[string]interface{}{
+				"id":    key.Slug,
+				"error": err.Error(),
+			})
+		}
+
+		if !filter.matches(key, info) {
+			continue
 		}
+
+		data.SSHKeys = append(data.SSHKeys, newSSHKeysElementModel(key, info))
 	}
 
 	tflog.Info(ctx, "SSH keys data source read successfully", map[string]interface{}{
-		"count": len(sshKeys),
+		"count": len(data.SSHKeys),
+		"total": len(sshKeys),
 	})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// sshKeysFilter selects the SSH keys returned by the data source.
+type sshKeysFilter struct {
+	labelRegex  *regexp.Regexp
+	labelPrefix string
+	fingerprint string
+}
+
+func newSSHKeysFilter(data SSHKeysDataSourceModel) (*sshKeysFilter, error) {
+	filter := &sshKeysFilter{
+		labelPrefix: data.LabelPrefix.ValueString(),
+		fingerprint: data.Fingerprint.ValueString(),
+	}
+
+	if !data.LabelRegex.IsNull() {
+		re, err := regexp.Compile(data.LabelRegex.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid label_regex %q: %w", data.LabelRegex.ValueString(), err)
+		}
+		filter.labelRegex = re
+	}
+
+	return filter, nil
+}
+
+// matches reports whether the key passes every configured filter. info is
+// nil when the public key could not be parsed, in which case the key never
+// matches a fingerprint filter.
+func (f *sshKeysFilter) matches(key domains.SSHKey, info *keyInfo) bool {
+	if f.labelRegex != nil && !f.labelRegex.MatchString(key.Title) {
+		return false
+	}
+
+	if !strings.HasPrefix(key.Title, f.labelPrefix) {
+		return false
+	}
+
+	if f.fingerprint != "" && (info == nil || !info.matchesFingerprint(f.fingerprint)) {
+		return false
+	}
+
+	return true
+}
+
+func newSSHKeysElementModel(key domains.SSHKey, info *keyInfo) SSHKeysElementModel {
+	element := SSHKeysElementModel{
+		Id:                types.StringValue(key.Slug),
+		Label:             types.StringValue(key.Title),
+		PublicKey:         types.StringValue(key.PublicKey),
+		KeyType:           types.StringNull(),
+		Bits:              types.Int64Null(),
+		FingerprintSHA256: types.StringNull(),
+		FingerprintMD5:    types.StringNull(),
+	}
+
+	if info != nil {
+		element.KeyType = types.StringValue(info.Type)
+		if info.Bits > 0 {
+			element.Bits = types.Int64Value(int64(info.Bits))
+		}
+		element.FingerprintSHA256 = types.StringValue(info.FingerprintSHA256)
+		element.FingerprintMD5 = types.StringValue(info.FingerprintMD5)
+	}
+
+	return element
+}
@@ -4,6 +4,10 @@
 package sshkey_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -23,7 +27,7 @@ func TestAccSSHKeyDataSource(t *testing.T) {
 				Config: providerConfig + `
 resource "letscloud_ssh_key" "test" {
   label = "test-data-source-key"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
 }
 
 data "letscloud_ssh_key_lookup" "test_by_id" {
@@ -65,12 +69,12 @@ func TestAccSSHKeysDataSource(t *testing.T) {
 				Config: providerConfig + `
 resource "letscloud_ssh_key" "test1" {
   label = "test-keys-1"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test1@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test1@example.com"
 }
 
 resource "letscloud_ssh_key" "test2" {
   label = "test-keys-2"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test2@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test2@example.com"
 }
 
 data "letscloud_ssh_keys" "all" {
@@ -90,3 +94,170 @@ data "letscloud_ssh_keys" "all" {
 		},
 	})
 }
+
+func TestAccSSHKeysDataSource_Filters(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "alice" {
+  label = "team-alice"
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"
+}
+
+resource "letscloud_ssh_key" "bob" {
+  label = "team-bob"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l bob@example.com"
+}
+
+resource "letscloud_ssh_key" "ci" {
+  label = "ci-deploy"
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com"
+}
+
+data "letscloud_ssh_keys" "team" {
+  label_prefix = "team-"
+  depends_on   = [letscloud_ssh_key.alice, letscloud_ssh_key.bob, letscloud_ssh_key.ci]
+}
+
+data "letscloud_ssh_keys" "regex" {
+  label_regex = "^(ci|team-b)"
+  depends_on  = [letscloud_ssh_key.alice, letscloud_ssh_key.bob, letscloud_ssh_key.ci]
+}
+
+data "letscloud_ssh_keys" "fingerprint" {
+  fingerprint = "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"
+  depends_on  = [letscloud_ssh_key.alice, letscloud_ssh_key.bob, letscloud_ssh_key.ci]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.team", "ssh_keys.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.letscloud_ssh_keys.team", "ssh_keys.*", map[string]string{
+						"label":              "team-bob",
+						"key_type":           "ssh-rsa",
+						"bits":               "2048",
+						"fingerprint_sha256": "SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I",
+						"fingerprint_md5":    "MD5:0b:0f:98:d8:34:15:1e:52:78:57:2e:9a:3e:ac:9e:82",
+					}),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.regex", "ssh_keys.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.letscloud_ssh_keys.regex", "ssh_keys.*", map[string]string{
+						"label":              "ci-deploy",
+						"key_type":           "ssh-ed25519",
+						"fingerprint_sha256": "SHA256:ldTKCZegpm0mGwo05DjqGC8Ykm339oibbrII3ou07Os",
+					}),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.fingerprint", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.fingerprint", "ssh_keys.0.label", "team-alice"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.fingerprint", "ssh_keys.0.key_type", "ssh-ed25519"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_keys.fingerprint", "ssh_keys.0.bits", "256"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSSHKeyIdsKeys = `
+resource "letscloud_ssh_key" "alice" {
+  label = "alice"
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"
+}
+
+resource "letscloud_ssh_key" "ci" {
+  label = "ci"
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com"
+}
+`
+
+func TestAccSSHKeyIdsDataSource(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSSHKeyIdsKeys + `
+data "letscloud_ssh_key_ids" "test" {
+  labels     = ["ci", "alice", "ci"]
+  depends_on = [letscloud_ssh_key.alice, letscloud_ssh_key.ci]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.letscloud_ssh_key_ids.test", "ids.0", "letscloud_ssh_key.ci", "id"),
+					resource.TestCheckResourceAttrPair("data.letscloud_ssh_key_ids.test", "ids.1", "letscloud_ssh_key.alice", "id"),
+					resource.TestCheckResourceAttrPair("data.letscloud_ssh_key_ids.test", "ids_by_label.alice", "letscloud_ssh_key.alice", "id"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "missing_labels.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + testAccSSHKeyIdsKeys + `
+data "letscloud_ssh_key_ids" "test" {
+  labels     = ["alice", "bob"]
+  depends_on = [letscloud_ssh_key.alice, letscloud_ssh_key.ci]
+}
+`,
+				ExpectError: regexp.MustCompile(`No SSH key found with label\(s\): 'bob'`),
+			},
+			{
+				Config: providerConfig + testAccSSHKeyIdsKeys + `
+data "letscloud_ssh_key_ids" "test" {
+  labels     = ["alice", "bob"]
+  strict     = false
+  depends_on = [letscloud_ssh_key.alice, letscloud_ssh_key.ci]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.letscloud_ssh_key_ids.test", "ids.0", "letscloud_ssh_key.alice", "id"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "ids_by_label.%", "1"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "missing_labels.#", "1"),
+					resource.TestCheckResourceAttr("data.letscloud_ssh_key_ids.test", "missing_labels.0", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRemoteSSHKeysDataSource(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com")
+		fmt.Fprintln(w, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ")
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "letscloud_remote_ssh_keys" "test" {
+  url = %q
+}
+`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.letscloud_remote_ssh_keys.test", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.letscloud_remote_ssh_keys.test", "keys.0.comment", "alice@example.com"),
+					resource.TestCheckResourceAttr("data.letscloud_remote_ssh_keys.test", "keys.0.fingerprint_sha256", "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"),
+					resource.TestCheckResourceAttr("data.letscloud_remote_ssh_keys.test", "public_keys.1", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ"),
+				),
+			},
+			// A key that is not pinned fails the read
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "letscloud_remote_ssh_keys" "test" {
+  url                 = %q
+  pinned_fingerprints = ["SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"]
+}
+`, server.URL),
+				ExpectError: regexp.MustCompile("Unpinned SSH Key"),
+			},
+		},
+	})
+}
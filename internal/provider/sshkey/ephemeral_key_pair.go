@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/letscloud-go/domains"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/labelprefix"
+	"golang.org/x/crypto/ssh"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResourceWithConfigure = &SSHKeyPairEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &SSHKeyPairEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &SSHKeyPairEphemeralResource{}
+
+const (
+	// privateKeyRegisteredID is the private data key holding the ID of the
+	// registered SSH key, which Close deletes.
+	privateKeyRegisteredID = "registered_id"
+
+	// defaultRSAKeyBits is the size of generated RSA keys unless configured.
+	defaultRSAKeyBits = 4096
+
+	// ephemeralLabelPrefix is used for registered keys without a label.
+	ephemeralLabelPrefix = "ephemeral-"
+)
+
+func NewSSHKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &SSHKeyPairEphemeralResource{}
+}
+
+// SSHKeyPairEphemeralResource defines the ephemeral resource implementation.
+type SSHKeyPairEphemeralResource struct {
+	client client.LetsCloudClient
+}
+
+// SSHKeyPairEphemeralResourceModel describes the ephemeral resource data model.
+type SSHKeyPairEphemeralResourceModel struct {
+	Algorithm         types.String `tfsdk:"algorithm"`
+	RSABits           types.Int64  `tfsdk:"rsa_bits"`
+	Comment           types.String `tfsdk:"comment"`
+	Register          types.Bool   `tfsdk:"register"`
+	Label             types.String `tfsdk:"label"`
+	Id                types.String `tfsdk:"id"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	PrivateKey        types.String `tfsdk:"private_key_openssh"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+}
+
+func (r *SSHKeyPairEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_pair"
+}
+
+func (r *SSHKeyPairEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an SSH key pair in memory, without storing the private key in state or plan files. " +
+			"The public key can optionally be registered in the account for as long as the ephemeral resource is open.",
+
+		Attributes: map[string]schema.Attribute{
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "The key algorithm, either `ed25519` or `rsa`. Defaults to `ed25519`.",
+				Optional:            true,
+			},
+			"rsa_bits": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The size of RSA keys in bits, at least %d. Defaults to `%d`.", minRSAKeyBits, defaultRSAKeyBits),
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "The comment appended to the public key.",
+				Optional:            true,
+			},
+			"register": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the public key is registered as an SSH key in the account and deleted again when the ephemeral resource is closed. Defaults to `false`.",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The label of the registered SSH key. Defaults to a unique label beginning with `%s`.", ephemeralLabelPrefix),
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the registered SSH key, or null if `register` is not `true`.",
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key in authorized_keys format.",
+				Computed:            true,
+			},
+			"private_key_openssh": schema.StringAttribute{
+				MarkdownDescription: "The private key in OpenSSH PEM format.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 fingerprint of the key.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SSHKeyPairEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the algorithm and key size.
+func (r *SSHKeyPairEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data SSHKeyPairEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Algorithm.IsNull() && !data.Algorithm.IsUnknown() {
+		switch data.Algorithm.ValueString() {
+		case "ed25519", "rsa":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("algorithm"),
+				"Invalid Algorithm",
+				fmt.Sprintf("Expected ed25519 or rsa, got %q.", data.Algorithm.ValueString()),
+			)
+		}
+	}
+
+	if !data.RSABits.IsNull() && !data.RSABits.IsUnknown() && data.RSABits.ValueInt64() < minRSAKeyBits {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rsa_bits"),
+			"Invalid RSA Key Size",
+			fmt.Sprintf("RSA keys must be at least %d bits, got %d.", minRSAKeyBits, data.RSABits.ValueInt64()),
+		)
+	}
+}
+
+func (r *SSHKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHKeyPairEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bits := int64(defaultRSAKeyBits)
+	if !data.RSABits.IsNull() {
+		bits = data.RSABits.ValueInt64()
+	}
+
+	publicKey, privateKey, err := generateKeyPair(data.Algorithm.ValueString(), int(bits), data.Comment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", fmt.Sprintf("Unable to generate SSH key pair, got error: %s", err))
+		return
+	}
+
+	data.PublicKey = types.StringValue(strings.TrimSpace(publicKey))
+	data.PrivateKey = types.StringValue(privateKey)
+	data.Id = types.StringNull()
+
+	info, err := parseKeyInfo(publicKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", fmt.Sprintf("Unable to parse generated public key, got error: %s", err))
+		return
+	}
+	data.FingerprintSHA256 = types.StringValue(info.FingerprintSHA256)
+
+	if data.Label.IsNull() {
+		data.Label = types.StringValue(labelprefix.Generate(ephemeralLabelPrefix))
+	}
+
+	if data.Register.ValueBool() {
+		sshKey, err := r.client.CreateSSHKey(&domains.SSHKeyCreateRequest{
+			Title: data.Label.ValueString(),
+			Key:   info.authorizedKey(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to register SSH key, got error: %s", err))
+			return
+		}
+
+		registeredID, err := json.Marshal(sshKey.Slug)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode SSH key identifier, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyRegisteredID, registeredID)...)
+
+		data.Id = types.StringValue(sshKey.Slug)
+
+		tflog.Info(ctx, "Registered ephemeral SSH key", map[string]interface{}{
+			"id":    sshKey.Slug,
+			"label": data.Label.ValueString(),
+		})
+	}
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the SSH key registered by Open, if any.
+func (r *SSHKeyPairEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, privateKeyRegisteredID)
+	resp.Diagnostics.Append(diags...)
+	if len(raw) == 0 {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(raw, &id); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode SSH key identifier, got error: %s", err))
+		return
+	}
+
+	if err := r.client.DeleteSSHKey(id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete registered SSH key %s, got error: %s", id, err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted ephemeral SSH key", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// generateKeyPair returns a new public key in authorized_keys format and its
+// private key in OpenSSH PEM format. An empty algorithm means ed25519.
+func generateKeyPair(algorithm string, rsaBits int, comment string) (string, string, error) {
+	var privateKey crypto.PrivateKey
+	var publicKey crypto.PublicKey
+
+	switch algorithm {
+	case "", "ed25519":
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", err
+		}
+		publicKey, privateKey = pub, priv
+	case "rsa":
+		if rsaBits < minRSAKeyBits {
+			return "", "", fmt.Errorf("RSA keys must be at least %d bits, got %d", minRSAKeyBits, rsaBits)
+		}
+		priv, err := rsa.GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return "", "", err
+		}
+		publicKey, privateKey = &priv.PublicKey, priv
+	default:
+		return "", "", fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, comment)
+	if err != nil {
+		return "", "", err
+	}
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey)))
+	if comment != "" {
+		authorizedKey += " " + comment
+	}
+
+	return authorizedKey + "\n", string(pem.EncodeToMemory(block)), nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseAuthorizedKeysFunction{}
+
+// authorizedKeyAttrTypes are the attributes of each object returned by
+// parse_authorized_keys.
+var authorizedKeyAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"key":         types.StringType,
+	"comment":     types.StringType,
+	"options":     types.ListType{ElemType: types.StringType},
+	"fingerprint": types.StringType,
+}
+
+func NewParseAuthorizedKeysFunction() function.Function {
+	return &ParseAuthorizedKeysFunction{}
+}
+
+// ParseAuthorizedKeysFunction defines the function implementation.
+type ParseAuthorizedKeysFunction struct{}
+
+// AuthorizedKeyModel describes an object returned by parse_authorized_keys.
+type AuthorizedKeyModel struct {
+	Type        types.String   `tfsdk:"type"`
+	Key         types.String   `tfsdk:"key"`
+	Comment     types.String   `tfsdk:"comment"`
+	Options     []types.String `tfsdk:"options"`
+	Fingerprint types.String   `tfsdk:"fingerprint"`
+}
+
+func (f *ParseAuthorizedKeysFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_authorized_keys"
+}
+
+func (f *ParseAuthorizedKeysFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse the content of an authorized_keys file",
+		MarkdownDescription: "Returns a list of objects with the `type`, `key`, `comment`, `options` and SHA256 `fingerprint` of every key in an authorized_keys file, in file order. " +
+			"`key` is the key type and data without options or comment, as accepted by `letscloud_ssh_key`. " +
+			"Blank lines and lines starting with `#` are skipped. Malformed lines are skipped too, unless `strict` is `true`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The content of an authorized_keys file.",
+			},
+		},
+		VariadicParameter: function.BoolParameter{
+			Name:                "strict",
+			MarkdownDescription: "Whether a malformed line is an error. Defaults to `false`.",
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: authorizedKeyAttrTypes},
+		},
+	}
+}
+
+func (f *ParseAuthorizedKeysFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	var strict []bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content, &strict))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(strict) > 1 {
+		resp.Error = function.NewArgumentFuncError(2, "Only one strict argument can be given")
+		return
+	}
+
+	entries, errs := parseAuthorizedKeys(content)
+	if len(strict) == 1 && strict[0] && len(errs) > 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid authorized_keys content: %s", errs[0]))
+		return
+	}
+
+	result := make([]AuthorizedKeyModel, 0, len(entries))
+	for _, entry := range entries {
+		options := make([]types.String, 0, len(entry.Key.Options))
+		for _, option := range entry.Key.Options {
+			options = append(options, types.StringValue(option))
+		}
+
+		result = append(result, AuthorizedKeyModel{
+			Type:        types.StringValue(entry.Key.Type),
+			Key:         types.StringValue(entry.Key.authorizedKey()),
+			Comment:     types.StringValue(entry.Key.Comment),
+			Options:     options,
+			Fingerprint: types.StringValue(entry.Key.FingerprintSHA256),
+		})
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
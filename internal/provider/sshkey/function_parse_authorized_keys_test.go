@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runParseAuthorizedKeys(content string, strict ...bool) function.RunResponse {
+	elemTypes := make([]attr.Type, 0, len(strict))
+	elems := make([]attr.Value, 0, len(strict))
+	for _, s := range strict {
+		elemTypes = append(elemTypes, types.BoolType)
+		elems = append(elems, types.BoolValue(s))
+	}
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: authorizedKeyAttrTypes})),
+	}
+	NewParseAuthorizedKeysFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(content),
+			types.TupleValueMust(elemTypes, elems),
+		}),
+	}, &resp)
+
+	return resp
+}
+
+func TestParseAuthorizedKeysFunction(t *testing.T) {
+	content := strings.Join([]string{
+		"# team keys",
+		"",
+		testKeyEd25519,
+		"not a key",
+		`from="10.0.0.0/8",no-pty ` + testKeyRSA,
+	}, "\n")
+
+	resp := runParseAuthorizedKeys(content)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	objectType := types.ObjectType{AttrTypes: authorizedKeyAttrTypes}
+	want := types.ListValueMust(objectType, []attr.Value{
+		types.ObjectValueMust(authorizedKeyAttrTypes, map[string]attr.Value{
+			"type":        types.StringValue("ssh-ed25519"),
+			"key":         types.StringValue(strings.TrimSuffix(testKeyEd25519, " alice@example.com")),
+			"comment":     types.StringValue("alice@example.com"),
+			"options":     types.ListValueMust(types.StringType, []attr.Value{}),
+			"fingerprint": types.StringValue("SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"),
+		}),
+		types.ObjectValueMust(authorizedKeyAttrTypes, map[string]attr.Value{
+			"type":    types.StringValue("ssh-rsa"),
+			"key":     types.StringValue(strings.TrimSuffix(testKeyRSA, " bob@example.com")),
+			"comment": types.StringValue("bob@example.com"),
+			"options": types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(`from="10.0.0.0/8"`),
+				types.StringValue("no-pty"),
+			}),
+			"fingerprint": types.StringValue("SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I"),
+		}),
+	})
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("got %s, want %s", resp.Result.Value(), want)
+	}
+
+	if resp := runParseAuthorizedKeys(content, false); resp.Error != nil {
+		t.Errorf("unexpected error with strict = false: %s", resp.Error)
+	}
+
+	resp = runParseAuthorizedKeys(content, true)
+	if resp.Error == nil || !strings.Contains(resp.Error.Error(), "line 4") {
+		t.Errorf("expected error for line 4 with strict = true, got %v", resp.Error)
+	}
+
+	if resp := runParseAuthorizedKeys(content, true, true); resp.Error == nil {
+		t.Error("expected error for repeated strict argument")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseSSHKeyFunction{}
+
+// parsedSSHKeyAttrTypes are the attributes of the object returned by
+// parse_ssh_key.
+var parsedSSHKeyAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"bits":    types.Int64Type,
+	"comment": types.StringType,
+}
+
+func NewParseSSHKeyFunction() function.Function {
+	return &ParseSSHKeyFunction{}
+}
+
+// ParseSSHKeyFunction defines the function implementation.
+type ParseSSHKeyFunction struct{}
+
+// ParsedSSHKeyModel describes the object returned by parse_ssh_key.
+type ParsedSSHKeyModel struct {
+	Type    types.String `tfsdk:"type"`
+	Bits    types.Int64  `tfsdk:"bits"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (f *ParseSSHKeyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_ssh_key"
+}
+
+func (f *ParseSSHKeyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an SSH public key",
+		MarkdownDescription: "Returns the `type`, e.g. `ssh-ed25519`, the size in `bits` and the `comment` of a public key in authorized_keys format. The comment is empty if the key has none.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The public key in authorized_keys format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedSSHKeyAttrTypes,
+		},
+	}
+}
+
+func (f *ParseSSHKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	info, err := parseKeyInfo(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid SSH public key: %s", err))
+		return
+	}
+
+	result := ParsedSSHKeyModel{
+		Type:    types.StringValue(info.Type),
+		Bits:    types.Int64Value(int64(info.Bits)),
+		Comment: types.StringValue(info.Comment),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseSSHKeyFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		want    map[string]attr.Value
+		wantErr bool
+	}{
+		{
+			name: "ed25519",
+			key:  testKeyEd25519,
+			want: map[string]attr.Value{
+				"type":    types.StringValue("ssh-ed25519"),
+				"bits":    types.Int64Value(256),
+				"comment": types.StringValue("alice@example.com"),
+			},
+		},
+		{
+			name: "without comment",
+			key:  "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBBIWT5cAyi5VKwr98bwl+oSo7EiMBNsoB0OVdDIi401pb0jsmPoJZw26ejdwUjuiwtnZb/MZw9jw5GU6FbcbQvc=",
+			want: map[string]attr.Value{
+				"type":    types.StringValue("ecdsa-sha2-nistp256"),
+				"bits":    types.Int64Value(256),
+				"comment": types.StringValue(""),
+			},
+		},
+		{
+			name:    "invalid",
+			key:     "ssh-ed25519 invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(parsedSSHKeyAttrTypes))}
+			NewParseSSHKeyFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.key)}),
+			}, &resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			want := types.ObjectValueMust(parsedSSHKeyAttrTypes, tt.want)
+			if !resp.Result.Value().Equal(want) {
+				t.Errorf("got %s, want %s", resp.Result.Value(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SSHKeyFingerprintFunction{}
+
+func NewSSHKeyFingerprintFunction() function.Function {
+	return &SSHKeyFingerprintFunction{}
+}
+
+// SSHKeyFingerprintFunction defines the function implementation.
+type SSHKeyFingerprintFunction struct{}
+
+func (f *SSHKeyFingerprintFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ssh_key_fingerprint"
+}
+
+func (f *SSHKeyFingerprintFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the fingerprint of an SSH public key",
+		MarkdownDescription: "Returns the fingerprint of a public key in authorized_keys format, as printed by `ssh-keygen -l -E <algorithm>`, e.g. `SHA256:...` or `MD5:...`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "The public key in authorized_keys format.",
+			},
+			function.StringParameter{
+				Name:                "algorithm",
+				MarkdownDescription: "The fingerprint hash, either `sha256` or `md5`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SSHKeyFingerprintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key, algorithm string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key, &algorithm))
+	if resp.Error != nil {
+		return
+	}
+
+	info, err := parseKeyInfo(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid SSH public key: %s", err))
+		return
+	}
+
+	var fingerprint string
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		fingerprint = info.FingerprintSHA256
+	case "md5":
+		fingerprint = info.FingerprintMD5
+	default:
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Unsupported fingerprint algorithm %q, expected sha256 or md5", algorithm))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fingerprint))
+}
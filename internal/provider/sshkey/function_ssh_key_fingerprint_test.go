@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSSHKeyFingerprintFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		algorithm string
+		want      string
+		wantErr   bool
+	}{
+		{name: "sha256", key: testKeyEd25519, algorithm: "sha256", want: "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"},
+		{name: "md5", key: testKeyRSA, algorithm: "MD5", want: "MD5:0b:0f:98:d8:34:15:1e:52:78:57:2e:9a:3e:ac:9e:82"},
+		{name: "unsupported type", key: testKeyDSA, algorithm: "sha256", want: "SHA256:4qk8TPdRa/wXPt3jvJYwFYSwRbUypGBfFK+uaZvCQxA"},
+		{name: "invalid key", key: "not a key", algorithm: "sha256", wantErr: true},
+		{name: "invalid algorithm", key: testKeyEd25519, algorithm: "sha1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewSSHKeyFingerprintFunction().Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.key), types.StringValue(tt.algorithm)}),
+			}, &resp)
+
+			if tt.wantErr {
+				if resp.Error == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if want := types.StringValue(tt.want); !resp.Result.Value().Equal(want) {
+				t.Errorf("got %s, want %s", resp.Result.Value(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"crypto/dsa" //nolint:staticcheck // DSA keys are still reported so they can be audited.
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// keyInfo holds the details of an SSH public key in authorized_keys format.
+type keyInfo struct {
+	PublicKey         ssh.PublicKey
+	Type              string
+	Bits              int
+	Comment           string
+	Options           []string
+	FingerprintSHA256 string
+	FingerprintMD5    string
+}
+
+// parseKeyInfo parses a single public key in authorized_keys format, such as
+// the contents of an id_ed25519.pub file. ssh.ParseAuthorizedKey skips lines
+// it cannot parse, so any content besides the one key line is rejected first.
+func parseKeyInfo(key string) (*keyInfo, error) {
+	lines := 0
+	for _, line := range strings.Split(key, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines++
+		}
+	}
+	if lines > 1 {
+		return nil, fmt.Errorf("expected a single public key, found additional content")
+	}
+
+	publicKey, comment, options, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyInfo{
+		PublicKey:         publicKey,
+		Type:              publicKey.Type(),
+		Bits:              keyBits(publicKey),
+		Comment:           comment,
+		Options:           options,
+		FingerprintSHA256: ssh.FingerprintSHA256(publicKey),
+		FingerprintMD5:    "MD5:" + ssh.FingerprintLegacyMD5(publicKey),
+	}, nil
+}
+
+// minRSAKeyBits is the smallest RSA modulus accepted for new keys.
+const minRSAKeyBits = 2048
+
+// supportedKeyTypes lists the public key algorithms LetsCloud accepts.
+var supportedKeyTypes = []string{
+	ssh.KeyAlgoRSA,
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoSKED25519,
+	ssh.KeyAlgoSKECDSA256,
+}
+
+// parseSupportedKey parses key like parseKeyInfo and additionally rejects
+// keys that checkSupported rejects.
+func parseSupportedKey(key string) (*keyInfo, error) {
+	info, err := parseKeyInfo(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := info.checkSupported(); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// checkSupported rejects key types LetsCloud does not accept, RSA keys below
+// minRSAKeyBits and authorized_keys options, which cannot be uploaded.
+func (k *keyInfo) checkSupported() error {
+	if !slices.Contains(supportedKeyTypes, k.Type) {
+		return fmt.Errorf("key type %q is not supported, use one of: %s", k.Type, strings.Join(supportedKeyTypes, ", "))
+	}
+
+	if k.Type == ssh.KeyAlgoRSA && k.Bits < minRSAKeyBits {
+		return fmt.Errorf("RSA keys must be at least %d bits, got %d", minRSAKeyBits, k.Bits)
+	}
+
+	if len(k.Options) > 0 {
+		return fmt.Errorf("authorized_keys options are not supported, found %q", strings.Join(k.Options, ","))
+	}
+
+	return nil
+}
+
+// authorizedKey returns the key in authorized_keys format without options
+// or comment.
+func (k *keyInfo) authorizedKey() string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(k.PublicKey)))
+}
+
+// keyBits returns the size of the key in bits, or 0 if it is unknown.
+func keyBits(publicKey ssh.PublicKey) int {
+	cryptoKey, ok := publicKey.(ssh.CryptoPublicKey)
+	if !ok {
+		return 0
+	}
+
+	switch k := cryptoKey.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		return k.N.BitLen()
+	case *dsa.PublicKey:
+		return k.P.BitLen()
+	case *ecdsa.PublicKey:
+		return k.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return 256
+	}
+
+	return 0
+}
+
+// matchesFingerprint reports whether fingerprint identifies the key. Both the
+// SHA256 form and the MD5 form, with or without its "MD5:" prefix, are accepted.
+func (k *keyInfo) matchesFingerprint(fingerprint string) bool {
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == k.FingerprintSHA256 {
+		return true
+	}
+
+	return strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), strings.TrimPrefix(k.FingerprintMD5, "MD5:"))
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testKeyEd25519   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"
+	testKeyRSA       = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l bob@example.com"
+	testKeyECDSA256  = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBBIWT5cAyi5VKwr98bwl+oSo7EiMBNsoB0OVdDIi401pb0jsmPoJZw26ejdwUjuiwtnZb/MZw9jw5GU6FbcbQvc= dave@example.com"
+	testKeySKEd25519 = "sk-ssh-ed25519@openssh.com AAAAGnNrLXNzaC1lZDI1NTE5QG9wZW5zc2guY29tAAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4fAAAABHNzaDo= yubikey@example.com"
+	testKeyRSA1024   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQCoMbPNZfdjDk6aNDc9Xc4J1Bw1ajw1PgFkj7tvRRnQYZ9NS6zGYlUC8lZjekBo5hV0MagDyn92YgLz39avjwCp00Rje1lOls43mNrFPyfRxpLXRO4McjM9usRw0FLOwQo+gCWl8qBk/HcL3zUN14qfblcWNFDGfCuXfrEXQdqGiw== weak@example.com"
+	testKeyDSA       = "ssh-dss AAAAB3NzaC1kc3MAAACBAJCW/aNyx6CkgBVUQGNhYrxBzyhjAW5xKhT1h58DxuadfZqGXj1O4O2A788l1VbOvzukYCtKQb6kVZdXIFr8CDmiTNoq89Zr6f/a0qGYSF/ylVvvOOnofOIV3gMt7eI+Rtali3kcQhwgfTV+++9Q3z2q3zUwxG37bmnbQyvBIhOfAAAAFQCkMff6FWXepQxMRUwhciSXL6vT3wAAAIBrGU7DxPcS4AWwHsgK4D9/tSgjwZka4GR9rdrMDQwIrXXA8L0Z2KzqgIZ8ggOcqYLgAB4e15sMmXUvWchj77fifEB488xcbWIsl0doItgnzL9I24BnYX3M1loFuAMA8oVF9U/ZDi+g70nNqLDOlw0igBaP5AEm364Du3j33dnV7AAAAIEAg+LWxxY8wYg/n0G5yNPltEfj8O4niffHHCoPef+mbTrg2OmIoO6zOXClSIIknrtAThKwaECtKj24n/zByXcdc7ALMZMTWCdH9ZbQjXUR5PXy2m6jswjPowx7GMJMNJIAOYVES1Wy4Dp/CQClgQKQjTOKODPVg/mGAv+4wifw7iQ= legacy@example.com"
+	testKeyECDSA     = "ecdsa-sha2-nistp384 AAAAE2VjZHNhLXNoYTItbmlzdHAzODQAAAAIbmlzdHAzODQAAABhBIqY6khX06PBF2td79XHX6T2cn8LrG0dyd8KtXYM5dmoMj42eR7QqVizKHHPEkidPGdI/ol5rhGDsH13KuD0EWRgwX1274eu4M/MI21U+ELsVCz0RjJsu5wmwp6DLsrUIw== carol@example.com"
+)
+
+func TestParseKeyInfo(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		keyType string
+		bits    int
+		comment string
+		sha256  string
+		md5     string
+	}{
+		{
+			name:    "ed25519",
+			key:     testKeyEd25519,
+			keyType: "ssh-ed25519",
+			bits:    256,
+			comment: "alice@example.com",
+			sha256:  "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A",
+			md5:     "MD5:2e:b2:23:b6:26:14:a3:07:47:e7:a9:99:ae:c1:7a:c1",
+		},
+		{
+			name:    "rsa",
+			key:     testKeyRSA,
+			keyType: "ssh-rsa",
+			bits:    2048,
+			comment: "bob@example.com",
+			sha256:  "SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I",
+			md5:     "MD5:0b:0f:98:d8:34:15:1e:52:78:57:2e:9a:3e:ac:9e:82",
+		},
+		{
+			name:    "ecdsa",
+			key:     testKeyECDSA + "\n",
+			keyType: "ecdsa-sha2-nistp384",
+			bits:    384,
+			comment: "carol@example.com",
+			sha256:  "SHA256:pGsFfaKYNRZXZkfe7H/C8wgxS8clzMaIpS6gigFEKc8",
+			md5:     "MD5:4b:ff:c5:3f:01:23:bc:27:d4:d0:81:61:9e:f5:a0:ad",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := parseKeyInfo(tt.key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if info.Type != tt.keyType {
+				t.Errorf("expected type %q, got %q", tt.keyType, info.Type)
+			}
+			if info.Bits != tt.bits {
+				t.Errorf("expected %d bits, got %d", tt.bits, info.Bits)
+			}
+			if info.Comment != tt.comment {
+				t.Errorf("expected comment %q, got %q", tt.comment, info.Comment)
+			}
+			if info.FingerprintSHA256 != tt.sha256 {
+				t.Errorf("expected SHA256 fingerprint %q, got %q", tt.sha256, info.FingerprintSHA256)
+			}
+			if info.FingerprintMD5 != tt.md5 {
+				t.Errorf("expected MD5 fingerprint %q, got %q", tt.md5, info.FingerprintMD5)
+			}
+		})
+	}
+}
+
+func TestParseKeyInfo_Invalid(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"not a key",
+		"ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8Bs== truncated@example.com",
+		testKeyEd25519 + "\n" + testKeyRSA,
+		"garbage\n" + testKeyEd25519,
+		"# comment\n\n" + testKeyEd25519,
+	} {
+		if _, err := parseKeyInfo(key); err == nil {
+			t.Errorf("expected error parsing %q", key)
+		}
+	}
+}
+
+func TestKeyInfoMatchesFingerprint(t *testing.T) {
+	info, err := parseKeyInfo(testKeyEd25519)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, fingerprint := range []string{
+		"SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A",
+		"MD5:2e:b2:23:b6:26:14:a3:07:47:e7:a9:99:ae:c1:7a:c1",
+		"2E:B2:23:B6:26:14:A3:07:47:E7:A9:99:AE:C1:7A:C1",
+	} {
+		if !info.matchesFingerprint(fingerprint) {
+			t.Errorf("expected fingerprint %q to match", fingerprint)
+		}
+	}
+
+	if info.matchesFingerprint("SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I") {
+		t.Error("expected fingerprint of another key not to match")
+	}
+}
+
+func TestParseSupportedKey(t *testing.T) {
+	for _, key := range []string{testKeyEd25519, testKeyRSA, testKeyECDSA, testKeyECDSA256, testKeySKEd25519} {
+		info, err := parseSupportedKey(key)
+		if err != nil {
+			t.Errorf("expected %q to be accepted, got error: %s", key, err)
+			continue
+		}
+
+		if strings.Contains(info.authorizedKey(), "@example.com") {
+			t.Errorf("expected comment to be stripped, got %q", info.authorizedKey())
+		}
+	}
+
+	tests := map[string]struct {
+		key string
+		err string
+	}{
+		"dsa":       {key: testKeyDSA, err: `key type "ssh-dss" is not supported`},
+		"short rsa": {key: testKeyRSA1024, err: "RSA keys must be at least 2048 bits, got 1024"},
+		"options":   {key: `no-pty,from="10.0.0.1" ` + testKeyEd25519, err: "authorized_keys options are not supported"},
+		"truncated": {key: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es", err: "ssh: no key found"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseSupportedKey(tt.key)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got: %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	tests := map[string]struct {
+		algorithm string
+		bits      int
+		keyType   string
+	}{
+		"default": {algorithm: "", keyType: "ssh-ed25519"},
+		"ed25519": {algorithm: "ed25519", keyType: "ssh-ed25519"},
+		"rsa":     {algorithm: "rsa", bits: 2048, keyType: "ssh-rsa"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			publicKey, privateKey, err := generateKeyPair(tt.algorithm, tt.bits, "ci@example.com")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			info, err := parseSupportedKey(publicKey)
+			if err != nil {
+				t.Fatalf("generated public key does not parse: %s", err)
+			}
+			if info.Type != tt.keyType || info.Comment != "ci@example.com" {
+				t.Errorf("unexpected key type %q or comment %q", info.Type, info.Comment)
+			}
+
+			signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+			if err != nil {
+				t.Fatalf("generated private key does not parse: %s", err)
+			}
+			if !sameKey(publicKey, string(ssh.MarshalAuthorizedKey(signer.PublicKey()))) {
+				t.Error("private key does not match the public key")
+			}
+		})
+	}
+
+	if _, _, err := generateKeyPair("rsa", 1024, ""); err == nil {
+		t.Error("expected an error for a 1024 bit RSA key")
+	}
+	if _, _, err := generateKeyPair("dsa", 0, ""); err == nil {
+		t.Error("expected an error for an unsupported algorithm")
+	}
+}
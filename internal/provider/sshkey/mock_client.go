@@ -14,6 +14,9 @@ import (
 type MockLetsCloudClient struct {
 	sshKeys   map[string]*domains.SSHKey
 	instances map[string]*domains.Instance
+
+	// lastSSHKeyID keeps SSH key IDs unique after deletes
+	lastSSHKeyID int
 }
 
 // NewMockLetsCloudClient creates a new mock client.
@@ -29,6 +32,17 @@ func (m *MockLetsCloudClient) Close() {
 	// Nothing to do for mock client
 }
 
+// Account methods.
+func (m *MockLetsCloudClient) Profile() (*domains.Profile, error) {
+	return &domains.Profile{
+		Name:        "Mock User",
+		CompanyName: "Mock Company",
+		Email:       "mock@example.com",
+		Currency:    "USD",
+		Balance:     "100.00",
+	}, nil
+}
+
 // SSH Key methods.
 func (m *MockLetsCloudClient) SSHKey(id string) (*domains.SSHKey, error) {
 	if key, exists := m.sshKeys[id]; exists {
@@ -54,7 +68,8 @@ func (m *MockLetsCloudClient) CreateSSHKey(req *domains.SSHKeyCreateRequest) (*d
 	}
 
 	// Create new SSH key
-	id := fmt.Sprintf("mock-ssh-key-%d", len(m.sshKeys)+1)
+	m.lastSSHKeyID++
+	id := fmt.Sprintf("mock-ssh-key-%d", m.lastSSHKeyID)
 	key := &domains.SSHKey{
 		Slug:      id,
 		Title:     req.Title,
@@ -113,7 +128,19 @@ func (m *MockLetsCloudClient) CreateInstance(req *domains.CreateInstanceRequest)
 			{Address: "192.168.1.1"},
 			{Address: "2001:db8::1"},
 		},
+		TemplateLabel: req.ImageSlug,
 	}
+
+	// Size the instance after its plan
+	plans, _ := m.LocationPlans(req.LocationSlug)
+	for _, plan := range plans {
+		if plan.Slug == req.PlanSlug {
+			instance.CPUS = plan.Core
+			instance.Memory = plan.Memory
+			instance.TotalDiskSize = plan.Disk
+		}
+	}
+
 	m.instances[id] = instance
 	return nil
 }
@@ -133,6 +160,40 @@ func (m *MockLetsCloudClient) ResetPasswordInstance(id string, password string)
 	return nil
 }
 
+func (m *MockLetsCloudClient) RebootInstance(id string) error {
+	instance, exists := m.instances[id]
+	if !exists {
+		return fmt.Errorf("Instance not found: %s", id)
+	}
+	// Simulate the instance going down and coming back up
+	instance.Booted = false
+	return nil
+}
+
+// Catalog methods.
+func (m *MockLetsCloudClient) Locations() ([]domains.Location, error) {
+	return []domains.Location{
+		{
+			Slug:      "us-east-1",
+			Country:   "United States",
+			City:      "Miami",
+			Available: true,
+		},
+		{
+			Slug:      "br-sp-1",
+			Country:   "Brazil",
+			City:      "Sao Paulo",
+			Available: true,
+		},
+		{
+			Slug:      "eu-west-1",
+			Country:   "Netherlands",
+			City:      "Amsterdam",
+			Available: false,
+		},
+	}, nil
+}
+
 func (m *MockLetsCloudClient) LocationPlans(location string) ([]domains.Plan, error) {
 	return []domains.Plan{
 		{
@@ -145,5 +206,45 @@ func (m *MockLetsCloudClient) LocationPlans(location string) ([]domains.Plan, er
 			MonthlyValue: "10.00",
 			CurrencyCode: "USD",
 		},
+		{
+			Slug:         "plan-4",
+			Shortcode:    "Large Plan",
+			Core:         4,
+			Memory:       8192,
+			Disk:         80,
+			Bandwidth:    4000,
+			MonthlyValue: "40.00",
+			CurrencyCode: "USD",
+		},
+		{
+			Slug:         "plan-2",
+			Shortcode:    "Standard Plan",
+			Core:         2,
+			Memory:       2048,
+			Disk:         20,
+			Bandwidth:    2000,
+			MonthlyValue: "20.00",
+			CurrencyCode: "USD",
+		},
+		{
+			Slug:         "plan-2-storage",
+			Shortcode:    "Storage Plan",
+			Core:         2,
+			Memory:       2048,
+			Disk:         50,
+			Bandwidth:    2000,
+			MonthlyValue: "20.00",
+			CurrencyCode: "USD",
+		},
+	}, nil
+}
+
+func (m *MockLetsCloudClient) LocationImages(location string) ([]domains.Image, error) {
+	return []domains.Image{
+		{Slug: "ubuntu-20.04-x86_64", Distro: "Ubuntu", OS: "Ubuntu 20.04 LTS"},
+		{Slug: "ubuntu-24.04-x86_64", Distro: "Ubuntu", OS: "Ubuntu 24.04 LTS"},
+		{Slug: "ubuntu-22.04-x86_64", Distro: "Ubuntu", OS: "Ubuntu 22.04 LTS"},
+		{Slug: "debian-11-x86_64", Distro: "Debian", OS: "Debian 11"},
+		{Slug: "debian-12-x86_64", Distro: "Debian", OS: "Debian 12"},
 	}, nil
 }
@@ -9,7 +9,24 @@ import (
 
 // SSHKeyResourceModel describes the resource data model.
 type SSHKeyResourceModel struct {
-	Label types.String `tfsdk:"label"`
-	Key   types.String `tfsdk:"key"`
-	Id    types.String `tfsdk:"id"`
+	Label         types.String   `tfsdk:"label"`
+	LabelPrefix   types.String   `tfsdk:"label_prefix"`
+	Key           PublicKeyValue `tfsdk:"key"`
+	Id            types.String   `tfsdk:"id"`
+	AdoptExisting types.Bool     `tfsdk:"adopt_existing"`
+
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	FingerprintMD5    types.String `tfsdk:"fingerprint_md5"`
+	KeyType           types.String `tfsdk:"key_type"`
+	KeyBits           types.Int64  `tfsdk:"key_bits"`
+	Comment           types.String `tfsdk:"comment"`
+}
+
+// setKeyInfo sets the attributes derived from the public key.
+func (m *SSHKeyResourceModel) setKeyInfo(info *keyInfo) {
+	m.FingerprintSHA256 = types.StringValue(info.FingerprintSHA256)
+	m.FingerprintMD5 = types.StringValue(info.FingerprintMD5)
+	m.KeyType = types.StringValue(info.Type)
+	m.KeyBits = types.Int64Value(int64(info.Bits))
+	m.Comment = types.StringValue(info.Comment)
 }
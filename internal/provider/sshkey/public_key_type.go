@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom types satisfy framework interfaces.
+var (
+	_ basetypes.StringTypable                    = PublicKeyType{}
+	_ basetypes.StringValuableWithSemanticEquals = PublicKeyValue{}
+)
+
+// PublicKeyType is a string type holding an SSH public key in
+// authorized_keys format.
+type PublicKeyType struct {
+	basetypes.StringType
+}
+
+func (t PublicKeyType) String() string {
+	return "sshkey.PublicKeyType"
+}
+
+func (t PublicKeyType) Equal(o attr.Type) bool {
+	other, ok := o.(PublicKeyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t PublicKeyType) ValueType(ctx context.Context) attr.Value {
+	return PublicKeyValue{}
+}
+
+func (t PublicKeyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PublicKeyValue{StringValue: in}, nil
+}
+
+func (t PublicKeyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// PublicKeyValue is an SSH public key. Two values are semantically equal
+// when they hold the same key, regardless of comment, options or whitespace.
+type PublicKeyValue struct {
+	basetypes.StringValue
+}
+
+// NewPublicKeyValue returns a known PublicKeyValue.
+func NewPublicKeyValue(value string) PublicKeyValue {
+	return PublicKeyValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewPublicKeyNull returns a null PublicKeyValue.
+func NewPublicKeyNull() PublicKeyValue {
+	return PublicKeyValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v PublicKeyValue) Type(ctx context.Context) attr.Type {
+	return PublicKeyType{}
+}
+
+func (v PublicKeyValue) Equal(o attr.Value) bool {
+	other, ok := o.(PublicKeyValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v PublicKeyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PublicKeyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return sameKey(v.ValueString(), newValue.ValueString()), diags
+}
+
+// sameKey reports whether both strings parse to the same public key.
+func sameKey(a, b string) bool {
+	keyA, err := parseKeyInfo(a)
+	if err != nil {
+		return false
+	}
+
+	keyB, err := parseKeyInfo(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(keyA.PublicKey.Marshal(), keyB.PublicKey.Marshal())
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPublicKeyValueSemanticEquals(t *testing.T) {
+	keyBlob := strings.Join(strings.Fields(testKeyEd25519)[:2], " ")
+
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"identical":          {prior: testKeyEd25519, new: testKeyEd25519, expected: true},
+		"comment stripped":   {prior: testKeyEd25519, new: keyBlob, expected: true},
+		"comment changed":    {prior: testKeyEd25519, new: keyBlob + " alice@laptop", expected: true},
+		"trailing newline":   {prior: testKeyEd25519 + "\n", new: keyBlob, expected: true},
+		"extra whitespace":   {prior: "  " + keyBlob + "   alice@example.com  \n", new: testKeyEd25519, expected: true},
+		"different key":      {prior: testKeyEd25519, new: testKeyRSA, expected: false},
+		"unparseable prior":  {prior: "not a key", new: testKeyEd25519, expected: false},
+		"unparseable values": {prior: "not a key", new: "not a key", expected: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := NewPublicKeyValue(tt.prior).StringSemanticEquals(context.Background(), NewPublicKeyValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if equal != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, equal)
+			}
+		})
+	}
+}
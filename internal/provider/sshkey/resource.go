@@ -5,7 +5,9 @@ package sshkey
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/letscloud-community/letscloud-go/domains"
@@ -13,11 +15,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/labelprefix"
 )
 
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &SSHKeyResource{}
+var _ resource.ResourceWithModifyPlan = &SSHKeyResource{}
+var _ resource.ResourceWithValidateConfig = &SSHKeyResource{}
+
+// privateKeyPriorID is the private state key holding the identifier of the
+// SSH key a plan started from, so the label check can ignore a key that is
+// about to be replaced.
+const privateKeyPriorID = "prior_id"
+
 // SSHKeyResource is the resource implementation.
 type SSHKeyResource struct {
 	client client.LetsCloudClient
@@ -36,25 +54,195 @@ func (r *SSHKeyResource) Metadata(ctx context.Context, req resource.MetadataRequ
 // Schema defines the schema for the resource.
 func (r *SSHKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an SSH key in LetsCloud. Note: Updates to existing SSH keys are not supported by the LetsCloud API.",
+		Description: "Manages an SSH key in LetsCloud. The LetsCloud API cannot update SSH keys, so changing the key or the label replaces the SSH key.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier for the SSH key.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"label": schema.StringAttribute{
-				Description: "The label for the SSH key.",
-				Required:    true,
+				Description: "The label for the SSH key. Changing it replaces the SSH key. Labels must be unique, so with create_before_destroy change the label together with the key, or use label_prefix. Conflicts with label_prefix.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label_prefix": schema.StringAttribute{
+				Description: "Creates a unique label beginning with this prefix, which is stored in label and known after apply. Changing it replaces the SSH key. Conflicts with label.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"key": schema.StringAttribute{
-				Description: "The public SSH key.",
+				Description: "The public SSH key in authorized_keys format. RSA, Ed25519, ECDSA and FIDO (sk-ssh-ed25519@openssh.com, sk-ecdsa-sha2-nistp256@openssh.com) keys are accepted; RSA keys must be at least 2048 bits. Changing the key material replaces the SSH key; changes to the comment or surrounding whitespace are applied in place.",
+				CustomType:  PublicKeyType{},
 				Required:    true,
-				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfKeyChanged,
+						"Replace the SSH key if the key material changes. Changes to the comment or whitespace are applied in place.",
+						"Replace the SSH key if the key material changes. Changes to the comment or whitespace are applied in place.",
+					),
+				},
+				Validators: []validator.String{
+					publicKeyValidator{},
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "When true, an existing SSH key with the same label and public key is brought under management instead of failing the plan. Adopting a key with a different public key is an error. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Description: "The SHA256 fingerprint of the key, as printed by ssh-keygen -l.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint_md5": schema.StringAttribute{
+				Description: "The legacy MD5 fingerprint of the key, prefixed with MD5:.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Description: "The key algorithm, e.g. ssh-ed25519 or ssh-rsa.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_bits": schema.Int64Attribute{
+				Description: "The key size in bits.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "The comment at the end of the key, usually user@host. Empty if the key has none.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+// ModifyPlan derives the key metadata from the configured key and reports
+// label conflicts with existing SSH keys at plan time.
+func (r *SSHKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An invalid key is reported by the validator on the key attribute
+	if !plan.Key.IsUnknown() {
+		if info, err := parseKeyInfo(plan.Key.ValueString()); err == nil {
+			plan.setKeyInfo(info)
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state *SSHKeyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		priorID, err := json.Marshal(state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode SSH key identifier, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyPriorID, priorID)...)
+		return
+	}
+
+	// The provider is not configured yet, e.g. when its configuration is unknown
+	if r.client == nil {
+		return
+	}
+
+	if plan.Label.IsUnknown() || plan.Label.IsNull() || plan.AdoptExisting.ValueBool() {
+		return
+	}
+
+	var priorID string
+	raw, diags := req.Private.GetKey(ctx, privateKeyPriorID)
+	resp.Diagnostics.Append(diags...)
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &priorID); err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode SSH key identifier, got error: %s", err))
+			return
+		}
+	}
+
+	existingKeys, err := r.client.SSHKeys()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", "Error checking for existing SSH keys: "+err.Error())
+		return
+	}
+
+	existing := findSSHKeyByLabel(existingKeys, plan.Label.ValueString())
+	if existing == nil || existing.Slug == priorID {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("label"),
+		"Label Already In Use",
+		fmt.Sprintf("Label '%s' is already used by SSH key %s. Please choose a different label, "+
+			"or set adopt_existing = true to manage the existing SSH key.", plan.Label.ValueString(), existing.Slug),
+	)
+}
+
+// ValidateConfig requires exactly one of label and label_prefix.
+func (r *SSHKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Label.IsNull() && !data.LabelPrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label_prefix"),
+			"Conflicting Attributes",
+			"Only one of label and label_prefix can be set.",
+		)
+		return
+	}
+
+	if data.Label.IsNull() && data.LabelPrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label"),
+			"Missing Required Attribute",
+			"One of label or label_prefix must be set.",
+		)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *SSHKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -81,24 +269,23 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Validate and clean SSH key
-	key := data.Key.ValueString()
-	if !strings.HasPrefix(key, "ssh-rsa ") && !strings.HasPrefix(key, "ssh-ed25519 ") {
-		resp.Diagnostics.AddError("Validation Error", "SSH key must start with 'ssh-rsa ' or 'ssh-ed25519 '")
-		return
+	// A label from label_prefix is generated only now, as Terraform plans
+	// again before applying and a plan-time label would differ between plans
+	if data.Label.IsUnknown() {
+		data.Label = types.StringValue(labelprefix.Generate(data.LabelPrefix.ValueString()))
 	}
 
-	// Remove any comments from the key
-	keyParts := strings.Split(key, " ")
-	if len(keyParts) < 2 {
-		resp.Diagnostics.AddError("Validation Error", "Invalid SSH key format")
+	// Remove any comment from the key, the validator has already checked it
+	info, err := parseSupportedKey(data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Invalid SSH key format", err.Error())
 		return
 	}
-	key = strings.Join(keyParts[:2], " ")
+	data.setKeyInfo(info)
 
 	createRequest := &domains.SSHKeyCreateRequest{
 		Title: data.Label.ValueString(),
-		Key:   key,
+		Key:   info.authorizedKey(),
 	}
 
 	// Validate required fields
@@ -119,15 +306,34 @@ func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	for _, key := range existingKeys {
-		if key.Title == createRequest.Title {
+	if existing := findSSHKeyByLabel(existingKeys, createRequest.Title); existing != nil {
+		if !data.AdoptExisting.ValueBool() {
 			tflog.Error(ctx, "Label already exists", map[string]interface{}{
 				"label": createRequest.Title,
-				"id":    key.Slug,
+				"id":    existing.Slug,
 			})
 			resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("Label '%s' already exists. Please choose a different label.", createRequest.Title))
 			return
 		}
+
+		if !sameFingerprint(existing.PublicKey, info) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key"),
+				"Existing SSH Key Does Not Match",
+				fmt.Sprintf("SSH key %s has label '%s' but a different public key. "+
+					"Update the configuration to match the existing key, or choose a different label.", existing.Slug, createRequest.Title),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Adopting existing SSH key with the same label", map[string]interface{}{
+			"label": createRequest.Title,
+			"id":    existing.Slug,
+		})
+
+		data.Id = types.StringValue(existing.Slug)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
 	}
 
 	sshKey, err := r.client.CreateSSHKey(createRequest)
@@ -160,12 +366,34 @@ func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	sshKey, err := r.client.SSHKey(data.Id.ValueString())
 	if err != nil {
+		// The API does not tell a missing key apart from other errors, so
+		// confirm it is gone before removing it and planning a new one
+		sshKeys, listErr := r.client.SSHKeys()
+		if listErr == nil && findSSHKeyByID(sshKeys, data.Id.ValueString()) == nil {
+			tflog.Warn(ctx, "SSH key no longer exists, removing it from state", map[string]interface{}{
+				"id": data.Id.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SSH key, got error: %s", err))
 		return
 	}
 
 	data.Label = types.StringValue(sshKey.Title)
-	// We don't update the key field as it's sensitive and not returned by the API
+
+	// Detect a changed key, or fill it in after import. The API returns the
+	// key without its comment, so the key in state is kept while it is the
+	// same key, otherwise the comment would be lost.
+	if _, err := parseKeyInfo(sshKey.PublicKey); err == nil && !sameKey(data.Key.ValueString(), sshKey.PublicKey) {
+		data.Key = NewPublicKeyValue(sshKey.PublicKey)
+	}
+
+	// Fill in the key metadata for state written before it was tracked
+	if info, err := parseKeyInfo(data.Key.ValueString()); err == nil {
+		data.setKeyInfo(info)
+	}
 
 	tflog.Info(ctx, "SSH key read successfully", map[string]interface{}{
 		"id":    sshKey.Slug,
@@ -175,12 +403,17 @@ func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update is not supported by the LetsCloud API.
+// Update only stores the new plan. Changes to the key material or the label
+// replace the SSH key, so only comment edits and provider-side attributes
+// such as adopt_existing reach this point.
 func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Updates to SSH keys are not supported by the LetsCloud API. Please delete and recreate the SSH key instead.",
-	)
+	var data *SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -202,7 +435,103 @@ func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
-// ImportState imports an existing resource into Terraform.
+// ImportState imports an existing resource into Terraform. Besides the SSH
+// key ID, the import ID can be "label:<label>" or "fingerprint:<fingerprint>".
 func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := req.ID
+
+	if label, ok := strings.CutPrefix(req.ID, "label:"); ok {
+		sshKeys, err := r.client.SSHKeys()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list SSH keys, got error: %s", err))
+			return
+		}
+
+		existing := findSSHKeyByLabel(sshKeys, label)
+		if existing == nil {
+			resp.Diagnostics.AddError("SSH Key Not Found", fmt.Sprintf("No SSH key found with label '%s'", label))
+			return
+		}
+		id = existing.Slug
+	} else if fingerprint, ok := strings.CutPrefix(req.ID, "fingerprint:"); ok {
+		sshKeys, err := r.client.SSHKeys()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list SSH keys, got error: %s", err))
+			return
+		}
+
+		existing, err := findSSHKeyByFingerprint(sshKeys, fingerprint)
+		if err != nil {
+			resp.Diagnostics.AddError("Ambiguous SSH Key Fingerprint", fmt.Sprintf("%s. Import one of them by ID instead.", err))
+			return
+		}
+		if existing == nil {
+			resp.Diagnostics.AddError("SSH Key Not Found", fmt.Sprintf("No SSH key found with fingerprint '%s'", fingerprint))
+			return
+		}
+		id = existing.Slug
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+}
+
+// findSSHKeyByFingerprint returns the SSH key with the given SHA256 or MD5
+// fingerprint, or nil if there is none. The same key can be uploaded more than
+// once under different labels, so it returns an error listing the candidate
+// IDs when more than one key matches.
+func findSSHKeyByFingerprint(keys []domains.SSHKey, fingerprint string) (*domains.SSHKey, error) {
+	var matches []domains.SSHKey
+	for _, key := range keys {
+		info, err := parseKeyInfo(key.PublicKey)
+		if err != nil {
+			continue
+		}
+
+		if info.matchesFingerprint(fingerprint) {
+			matches = append(matches, key)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, key := range matches {
+		ids = append(ids, key.Slug)
+	}
+	sort.Strings(ids)
+	return nil, fmt.Errorf("fingerprint '%s' matches %d SSH keys: %s", fingerprint, len(matches), strings.Join(ids, ", "))
+}
+
+// requiresReplaceIfKeyChanged requires replacement unless the planned key is
+// the same key as the one in state, e.g. with only its comment changed.
+func requiresReplaceIfKeyChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !sameKey(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+// findSSHKeyByLabel returns the SSH key with the given label, or nil if there is none.
+func findSSHKeyByLabel(keys []domains.SSHKey, label string) *domains.SSHKey {
+	for _, key := range keys {
+		if key.Title == label {
+			keyCopy := key
+			return &keyCopy
+		}
+	}
+	return nil
+}
+
+// findSSHKeyByID returns the SSH key with the given ID, or nil.
+func findSSHKeyByID(keys []domains.SSHKey, id string) *domains.SSHKey {
+	for _, key := range keys {
+		if key.Slug == id {
+			keyCopy := key
+			return &keyCopy
+		}
+	}
+	return nil
 }
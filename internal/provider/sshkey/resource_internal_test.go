@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/letscloud-community/letscloud-go/domains"
+)
+
+func TestFindSSHKeyByFingerprint(t *testing.T) {
+	const fingerprint = "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"
+
+	keys := []domains.SSHKey{
+		{Slug: "key-1", Title: "alice", PublicKey: testKeyEd25519},
+		{Slug: "key-2", Title: "bob", PublicKey: testKeyRSA},
+	}
+
+	key, err := findSSHKeyByFingerprint(keys, fingerprint)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if key == nil || key.Slug != "key-1" {
+		t.Fatalf("expected key-1, got %v", key)
+	}
+
+	key, err = findSSHKeyByFingerprint(keys, "SHA256:unknown")
+	if err != nil || key != nil {
+		t.Fatalf("expected no match, got %v, %v", key, err)
+	}
+
+	keys = append(keys, domains.SSHKey{Slug: "key-3", Title: "alice-copy", PublicKey: testKeyEd25519})
+
+	key, err = findSSHKeyByFingerprint(keys, fingerprint)
+	if err == nil {
+		t.Fatalf("expected an error for an ambiguous fingerprint, got %v", key)
+	}
+	if !strings.Contains(err.Error(), "key-1, key-3") {
+		t.Errorf("expected the candidate IDs in the error, got: %s", err)
+	}
+}
@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/letscloud-community/letscloud-go/domains"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/labelprefix"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithModifyPlan = &SSHKeySetResource{}
+var _ resource.ResourceWithValidateConfig = &SSHKeySetResource{}
+
+// SSHKeySetResource manages a group of SSH keys as one resource.
+type SSHKeySetResource struct {
+	client client.LetsCloudClient
+}
+
+// NewSSHKeySetResource is a helper function to simplify the provider implementation.
+func NewSSHKeySetResource() resource.Resource {
+	return &SSHKeySetResource{}
+}
+
+// SSHKeySetResourceModel describes the resource data model.
+type SSHKeySetResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Keys           types.Map    `tfsdk:"keys"`
+	AuthorizedKeys types.String `tfsdk:"authorized_keys"`
+	SSHKeyIds      types.Map    `tfsdk:"ssh_key_ids"`
+	Fingerprints   types.Map    `tfsdk:"fingerprints"`
+}
+
+// Metadata returns the resource type name.
+func (r *SSHKeySetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *SSHKeySetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of SSH keys, such as a team's authorized_keys file, as one resource. " +
+			"Keys are matched by label and fingerprint, so only added, removed or changed keys are created or deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the SSH key set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keys": schema.MapAttribute{
+				Description: "Map of label to public key in authorized_keys format. Conflicts with authorized_keys.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"authorized_keys": schema.StringAttribute{
+				Description: "The content of an authorized_keys file. The comment of each key is used as its label, so every key needs a unique comment. Conflicts with keys.",
+				Optional:    true,
+			},
+			"ssh_key_ids": schema.MapAttribute{
+				Description: "Map of label to the ID of the SSH key.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"fingerprints": schema.MapAttribute{
+				Description: "Map of label to the SHA256 fingerprint of the SSH key.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SSHKeySetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.LetsCloudClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.LetsCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig requires exactly one of keys and authorized_keys and checks
+// every key.
+func (r *SSHKeySetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Keys.IsNull() && !data.AuthorizedKeys.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("authorized_keys"),
+			"Conflicting Attributes",
+			"Only one of keys and authorized_keys can be set.",
+		)
+		return
+	}
+
+	if data.Keys.IsNull() && data.AuthorizedKeys.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keys"),
+			"Missing Required Attribute",
+			"One of keys or authorized_keys must be set.",
+		)
+		return
+	}
+
+	_, diags := data.desiredKeys(ctx)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the fingerprints from the configured keys, so keys that
+// were changed or deleted outside of Terraform show up as a diff.
+func (r *SSHKeySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := plan.desiredKeys(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || desired == nil {
+		return
+	}
+
+	fingerprints := make(map[string]string, len(desired))
+	for label, info := range desired {
+		fingerprints[label] = info.FingerprintSHA256
+	}
+
+	plan.Fingerprints, diags = types.MapValueFrom(ctx, types.StringType, fingerprints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the IDs when nothing needs to be reconciled
+	if !req.State.Raw.IsNull() {
+		var state SSHKeySetResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Fingerprints.Equal(plan.Fingerprints) {
+			plan.SSHKeyIds = state.SSHKeyIds
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the SSH keys and sets the initial Terraform state.
+func (r *SSHKeySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(labelprefix.Generate("ssh-key-set-"))
+	r.apply(ctx, &data, nil, nil, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the SSH keys that still exist.
+func (r *SSHKeySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := stringMap(ctx, data.SSHKeyIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sshKeys, err := r.client.SSHKeys()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list SSH keys, got error: %s", err))
+		return
+	}
+
+	byID := make(map[string]domains.SSHKey, len(sshKeys))
+	for _, key := range sshKeys {
+		byID[key.Slug] = key
+	}
+
+	ids := make(map[string]string, len(managed))
+	fingerprints := make(map[string]string, len(managed))
+	for label, id := range managed {
+		key, ok := byID[id]
+		if !ok || key.Title != label {
+			tflog.Warn(ctx, "SSH key of set no longer exists", map[string]interface{}{
+				"id":    id,
+				"label": label,
+			})
+			continue
+		}
+
+		ids[label] = id
+		fingerprints[label] = ""
+		if info, err := parseKeyInfo(key.PublicKey); err == nil {
+			fingerprints[label] = info.FingerprintSHA256
+		}
+	}
+
+	resp.Diagnostics.Append(data.setManaged(ctx, ids, fingerprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update adds, replaces and removes SSH keys so the account matches the plan.
+func (r *SSHKeySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := stringMap(ctx, state.SSHKeyIds)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := stringMap(ctx, state.Fingerprints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, managed, prior, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes every SSH key of the set.
+func (r *SSHKeySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHKeySetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := stringMap(ctx, data.SSHKeyIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, label := range sortedKeys(managed) {
+		if err := r.client.DeleteSSHKey(managed[label]); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SSH key '%s', got error: %s", label, err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "SSH key set deleted successfully", map[string]interface{}{
+		"id":    data.Id.ValueString(),
+		"count": len(managed),
+	})
+}
+
+// apply reconciles the account with the planned keys and saves the result.
+// The state is saved even when reconciling fails part way, so keys that were
+// already created stay tracked.
+func (r *SSHKeySetResource) apply(ctx context.Context, data *SSHKeySetResourceModel, managed, prior map[string]string, state stateSetter, diagnostics *diag.Diagnostics) {
+	desired, diags := data.desiredKeys(ctx)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	ids, err := reconcileSSHKeySet(ctx, r.client, desired, managed)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reconcile SSH key set, got error: %s", err))
+	}
+
+	diagnostics.Append(data.setManaged(ctx, ids, managedFingerprints(ids, managed, prior, desired))...)
+	diagnostics.Append(state.Set(ctx, data)...)
+}
+
+// managedFingerprints returns the fingerprints of the keys in ids. A key that
+// kept its ID is still the key from before, which after a failed reconcile
+// may no longer be desired, so its fingerprint is carried over from prior.
+func managedFingerprints(ids, managed, prior map[string]string, desired map[string]*keyInfo) map[string]string {
+	fingerprints := make(map[string]string, len(ids))
+	for label, id := range ids {
+		if managedID, ok := managed[label]; ok && managedID == id {
+			fingerprints[label] = prior[label]
+			continue
+		}
+		if info, ok := desired[label]; ok {
+			fingerprints[label] = info.FingerprintSHA256
+		}
+	}
+	return fingerprints
+}
+
+// stateSetter is the part of tfsdk.State used by apply.
+type stateSetter interface {
+	Set(ctx context.Context, val interface{}) diag.Diagnostics
+}
+
+// desiredKeys returns the configured keys by label, or nil if they are not
+// known yet.
+func (m *SSHKeySetResourceModel) desiredKeys(ctx context.Context) (map[string]*keyInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m.Keys.IsUnknown() || m.AuthorizedKeys.IsUnknown() {
+		return nil, diags
+	}
+
+	desired := make(map[string]*keyInfo)
+
+	if !m.Keys.IsNull() {
+		for label, value := range m.Keys.Elements() {
+			key, ok := value.(types.String)
+			if !ok || key.IsUnknown() {
+				return nil, diags
+			}
+
+			info, err := parseSupportedKey(key.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("keys").AtMapKey(label), "Invalid SSH key format", fmt.Sprintf("The value is not a valid SSH public key: %s.", err))
+				continue
+			}
+			desired[label] = info
+		}
+
+		return desired, diags
+	}
+
+	entries, errs := parseAuthorizedKeys(m.AuthorizedKeys.ValueString())
+	for _, err := range errs {
+		diags.AddAttributeError(path.Root("authorized_keys"), "Invalid SSH key format", err.Error())
+	}
+
+	lines := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		if err := entry.Key.checkSupported(); err != nil {
+			diags.AddAttributeError(path.Root("authorized_keys"), "Invalid SSH key format", fmt.Sprintf("line %d: %s", entry.Line, err))
+			continue
+		}
+
+		label := entry.Key.Comment
+		if label == "" {
+			diags.AddAttributeError(path.Root("authorized_keys"), "Missing SSH Key Label",
+				fmt.Sprintf("line %d: the key has no comment to use as its label", entry.Line))
+			continue
+		}
+
+		if line, exists := lines[label]; exists {
+			diags.AddAttributeError(path.Root("authorized_keys"), "Duplicate SSH Key Label",
+				fmt.Sprintf("line %d: the comment '%s' is already used on line %d", entry.Line, label, line))
+			continue
+		}
+
+		lines[label] = entry.Line
+		desired[label] = entry.Key
+	}
+
+	return desired, diags
+}
+
+// setManaged stores the IDs and fingerprints of the managed keys.
+func (m *SSHKeySetResourceModel) setManaged(ctx context.Context, ids, fingerprints map[string]string) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.SSHKeyIds, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	m.Fingerprints, d = types.MapValueFrom(ctx, types.StringType, fingerprints)
+	diags.Append(d...)
+
+	return diags
+}
+
+// reconcileSSHKeySet makes the account hold the desired keys and returns the
+// resulting label to ID map. managed holds the keys created by the set so
+// far. A managed key is kept when its label and fingerprint are unchanged;
+// otherwise it is deleted and, if still desired, created again. An unmanaged
+// key with a desired label is adopted if its fingerprint matches.
+func reconcileSSHKeySet(ctx context.Context, c client.LetsCloudClient, desired map[string]*keyInfo, managed map[string]string) (map[string]string, error) {
+	sshKeys, err := c.SSHKeys()
+	if err != nil {
+		return copyStringMap(managed), fmt.Errorf("unable to list SSH keys: %w", err)
+	}
+
+	byID := make(map[string]domains.SSHKey, len(sshKeys))
+	for _, key := range sshKeys {
+		byID[key.Slug] = key
+	}
+
+	result := make(map[string]string, len(desired))
+
+	// Delete managed keys that are gone from the configuration or changed.
+	// Labels are unique, so this has to happen before creating replacements.
+	for _, label := range sortedKeys(managed) {
+		id := managed[label]
+		key, exists := byID[id]
+		if !exists {
+			continue
+		}
+
+		if info, ok := desired[label]; ok && key.Title == label && sameFingerprint(key.PublicKey, info) {
+			result[label] = id
+			continue
+		}
+
+		if err := c.DeleteSSHKey(id); err != nil {
+			// The key still exists, so keep tracking it
+			result[label] = id
+			return result, fmt.Errorf("unable to delete SSH key '%s': %w", label, err)
+		}
+		delete(byID, id)
+
+		tflog.Info(ctx, "Deleted SSH key from set", map[string]interface{}{
+			"id":    id,
+			"label": label,
+		})
+	}
+
+	byLabel := make(map[string]domains.SSHKey, len(byID))
+	for _, key := range byID {
+		byLabel[key.Title] = key
+	}
+
+	for _, label := range sortedKeys(desired) {
+		if _, done := result[label]; done {
+			continue
+		}
+
+		info := desired[label]
+		if existing, ok := byLabel[label]; ok {
+			if !sameFingerprint(existing.PublicKey, info) {
+				return result, fmt.Errorf("label '%s' is already used by SSH key %s with a different key", label, existing.Slug)
+			}
+
+			result[label] = existing.Slug
+			tflog.Info(ctx, "Adopted existing SSH key into set", map[string]interface{}{
+				"id":    existing.Slug,
+				"label": label,
+			})
+			continue
+		}
+
+		sshKey, err := c.CreateSSHKey(&domains.SSHKeyCreateRequest{
+			Title: label,
+			Key:   info.authorizedKey(),
+		})
+		if err != nil {
+			return result, fmt.Errorf("unable to create SSH key '%s': %w", label, err)
+		}
+		result[label] = sshKey.Slug
+
+		tflog.Info(ctx, "Created SSH key in set", map[string]interface{}{
+			"id":    sshKey.Slug,
+			"label": label,
+		})
+	}
+
+	return result, nil
+}
+
+// sameFingerprint reports whether publicKey parses to the key in info.
+func sameFingerprint(publicKey string, info *keyInfo) bool {
+	parsed, err := parseKeyInfo(publicKey)
+	if err != nil {
+		return false
+	}
+
+	return parsed.FingerprintSHA256 == info.FingerprintSHA256
+}
+
+// stringMap converts a map of strings from the model, treating null and
+// unknown as empty.
+func stringMap(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	result := make(map[string]string)
+	if value.IsNull() || value.IsUnknown() {
+		return result, nil
+	}
+
+	diags := value.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/letscloud-community/letscloud-go/domains"
+	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider/client"
+)
+
+func testDesiredKeys(t *testing.T, keys map[string]string) map[string]*keyInfo {
+	t.Helper()
+
+	desired := make(map[string]*keyInfo, len(keys))
+	for label, key := range keys {
+		info, err := parseSupportedKey(key)
+		if err != nil {
+			t.Fatalf("parseSupportedKey(%s) returned error: %s", label, err)
+		}
+		desired[label] = info
+	}
+	return desired
+}
+
+func TestReconcileSSHKeySet(t *testing.T) {
+	ctx := context.Background()
+	c := NewMockLetsCloudClient()
+
+	// An unmanaged key with a desired label and the same key is adopted.
+	adopted, err := c.CreateSSHKey(&domains.SSHKeyCreateRequest{Title: "bob", Key: testKeyRSA})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids, err := reconcileSSHKeySet(ctx, c, testDesiredKeys(t, map[string]string{
+		"alice": testKeyEd25519,
+		"bob":   testKeyRSA,
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ids) != 2 || ids["bob"] != adopted.Slug || ids["alice"] == "" {
+		t.Fatalf("unexpected ids after create: %v", ids)
+	}
+	aliceID := ids["alice"]
+
+	// Changing bob's key replaces only bob, removing alice deletes her key.
+	ids, err = reconcileSSHKeySet(ctx, c, testDesiredKeys(t, map[string]string{
+		"bob":  testKeyECDSA256,
+		"dave": testKeyECDSA256,
+	}), ids)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ids) != 2 || ids["bob"] == adopted.Slug {
+		t.Fatalf("unexpected ids after update: %v", ids)
+	}
+	if _, err := c.SSHKey(aliceID); err == nil {
+		t.Errorf("expected SSH key %s to be deleted", aliceID)
+	}
+	if _, err := c.SSHKey(adopted.Slug); err == nil {
+		t.Errorf("expected SSH key %s to be deleted", adopted.Slug)
+	}
+
+	// Unchanged keys are kept as they are.
+	unchanged, err := reconcileSSHKeySet(ctx, c, testDesiredKeys(t, map[string]string{
+		"bob":  testKeyECDSA256,
+		"dave": testKeyECDSA256,
+	}), ids)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if unchanged["bob"] != ids["bob"] || unchanged["dave"] != ids["dave"] {
+		t.Errorf("expected ids to be kept, got %v, want %v", unchanged, ids)
+	}
+
+	keys, _ := c.SSHKeys()
+	if len(keys) != 2 {
+		t.Errorf("expected 2 SSH keys in the account, got %d", len(keys))
+	}
+}
+
+func TestReconcileSSHKeySet_LabelConflict(t *testing.T) {
+	ctx := context.Background()
+	c := NewMockLetsCloudClient()
+
+	if _, err := c.CreateSSHKey(&domains.SSHKeyCreateRequest{Title: "alice", Key: testKeyRSA}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids, err := reconcileSSHKeySet(ctx, c, testDesiredKeys(t, map[string]string{
+		"alice": testKeyEd25519,
+		"bob":   testKeyRSA,
+	}), nil)
+	if err == nil || !strings.Contains(err.Error(), "label 'alice' is already used") {
+		t.Fatalf("expected label conflict error, got %v", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("expected no managed keys, got %v", ids)
+	}
+}
+
+// failingSSHKeySetClient fails to list or delete SSH keys.
+type failingSSHKeySetClient struct {
+	client.LetsCloudClient
+	failList   bool
+	failDelete bool
+}
+
+func (c *failingSSHKeySetClient) SSHKeys() ([]domains.SSHKey, error) {
+	if c.failList {
+		return nil, errors.New("service unavailable")
+	}
+	return c.LetsCloudClient.SSHKeys()
+}
+
+func (c *failingSSHKeySetClient) DeleteSSHKey(id string) error {
+	if c.failDelete {
+		return errors.New("service unavailable")
+	}
+	return c.LetsCloudClient.DeleteSSHKey(id)
+}
+
+func TestManagedFingerprints_FailedReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]*failingSSHKeySetClient{
+		"delete fails": {failDelete: true},
+		"list fails":   {failList: true},
+	}
+
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			c.LetsCloudClient = NewMockLetsCloudClient()
+
+			desired := testDesiredKeys(t, map[string]string{"alice": testKeyEd25519})
+			managed, err := reconcileSSHKeySet(ctx, c.LetsCloudClient, desired, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			prior := managedFingerprints(managed, nil, nil, desired)
+
+			// Removing alice fails, so her key stays tracked with its fingerprint
+			desired = testDesiredKeys(t, map[string]string{"bob": testKeyRSA})
+			ids, err := reconcileSSHKeySet(ctx, c, desired, managed)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if ids["alice"] != managed["alice"] {
+				t.Fatalf("expected alice to stay tracked, got %v", ids)
+			}
+
+			fingerprints := managedFingerprints(ids, managed, prior, desired)
+			if fingerprints["alice"] != "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A" {
+				t.Errorf("expected alice's fingerprint to be carried over, got %v", fingerprints)
+			}
+		})
+	}
+}
+
+func TestSSHKeySetDesiredKeys_AuthorizedKeys(t *testing.T) {
+	ctx := context.Background()
+
+	model := SSHKeySetResourceModel{
+		Keys:           types.MapNull(types.StringType),
+		AuthorizedKeys: types.StringValue("# team\n" + testKeyEd25519 + "\n\n" + testKeyRSA + "\n"),
+	}
+
+	desired, diags := model.desiredKeys(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(desired) != 2 || desired["alice@example.com"] == nil || desired["bob@example.com"] == nil {
+		t.Errorf("expected keys labelled by comment, got %v", desired)
+	}
+
+	tests := map[string]string{
+		"duplicate comment": testKeyEd25519 + "\n" + testKeyEd25519,
+		"missing comment":   strings.TrimSuffix(testKeyEd25519, " alice@example.com"),
+		"unsupported key":   testKeyDSA,
+		"invalid line":      "not a key",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			model.AuthorizedKeys = types.StringValue(content)
+			if _, diags := model.desiredKeys(ctx); !diags.HasError() {
+				t.Errorf("expected error for %q", content)
+			}
+		})
+	}
+}
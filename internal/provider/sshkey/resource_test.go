@@ -4,12 +4,18 @@
 package sshkey_test
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/letscloud-community/letscloud-go/domains"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/letscloud-community/terraform-provider-letscloud/internal/provider"
 )
 
@@ -40,12 +46,17 @@ func TestAccSSHKeyResource(t *testing.T) {
 				Config: providerConfig + `
 resource "letscloud_ssh_key" "test" {
   label = "test-key"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "label", "test-key"),
 					resource.TestCheckResourceAttrSet("letscloud_ssh_key.test", "id"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "fingerprint_sha256", "SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "fingerprint_md5", "MD5:0b:0f:98:d8:34:15:1e:52:78:57:2e:9a:3e:ac:9e:82"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "key_type", "ssh-rsa"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "key_bits", "2048"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "comment", "test@example.com"),
 				),
 			},
 			// ImportState testing
@@ -53,8 +64,28 @@ resource "letscloud_ssh_key" "test" {
 				ResourceName:      "letscloud_ssh_key.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// The key field is sensitive and not returned by the API
-				ImportStateVerifyIgnore: []string{"key"},
+				// The API returns the key without its comment
+				ImportStateVerifyIgnore: []string{"key", "comment"},
+			},
+			{
+				ResourceName:            "letscloud_ssh_key.test",
+				ImportState:             true,
+				ImportStateId:           "label:test-key",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "comment"},
+			},
+			{
+				ResourceName:            "letscloud_ssh_key.test",
+				ImportState:             true,
+				ImportStateId:           "fingerprint:SHA256:S7e2YYy5sATc+0raXPRowp2/cmzCoiv2XEh6pkd6y+I",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "comment"},
+			},
+			{
+				ResourceName:  "letscloud_ssh_key.test",
+				ImportState:   true,
+				ImportStateId: "label:missing-key",
+				ExpectError:   regexp.MustCompile(`No SSH key found with label 'missing-key'`),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
@@ -74,7 +105,7 @@ func TestAccSSHKeyResource_DuplicateLabel(t *testing.T) {
 				Config: providerConfig + `
 resource "letscloud_ssh_key" "test1" {
   label = "duplicate-label"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -86,15 +117,69 @@ resource "letscloud_ssh_key" "test1" {
 				Config: providerConfig + `
 resource "letscloud_ssh_key" "test1" {
   label = "duplicate-label"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
 }
 
 resource "letscloud_ssh_key" "test2" {
   label = "duplicate-label"
-  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIi41q4x71KvIciZZq1cU8DADrcqbn3ySVhgfTrZG1yUloU3Jq7Nn8/7YzCMr0CFSf/7ZgRGW9P1QxJZz8K3mWJ2z2K3Uf1x1+9Z9L3Qw== test@example.com"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`Label 'duplicate-label' is already used by SSH key`),
+			},
+		},
+	})
+}
+
+func TestAccSSHKeyResource_AdoptExisting(t *testing.T) {
+	// Configura o mock client
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					_, err := mockClient.CreateSSHKey(&domains.SSHKeyCreateRequest{
+						Title: "existing-key",
+						Key:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l",
+					})
+					if err != nil {
+						t.Fatalf("unable to create existing SSH key: %s", err)
+					}
+				},
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  label = "existing-key"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`Label 'existing-key' is already used by SSH key`),
+			},
+			// A different key under the same label is not adopted
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  label          = "existing-key"
+  key            = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"
+  adopt_existing = true
 }
 `,
-				ExpectError: regexp.MustCompile(`SSH key with label 'duplicate-label' already exists`),
+				ExpectError: regexp.MustCompile(`Existing SSH Key Does Not Match`),
+			},
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  label          = "existing-key"
+  key            = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDiD9bTEs6NdIYI0DozwyheniYg6s+nEv0LdkF2euDFoUpNhTMk4NxG6dZQLqL5bQb72XwhviQh5Rga38GuAI3wJc7mAyQm/OVn+RdVYLfSxlEUa3dHOd6iHL4+5Px5qFpcFJ1QOy5NgC84WrZOJLxG7yhbVWhaLezik7Ju+S+gZ9g2cE3/q5EDJPntnDW27T8+CGogxT0inWtpvj5azN4i7dORWUzDR1q8DsX34cNBScrdCfI2jgEEJ3rt8JMrl2BINUXSET8D+LBPdc7odBfb9JliTIn6xSDRraoVjImwQdaOl5qfstSWbNpSiI9b/B37xcZP3yZdV4fB8J/oGE2l test@example.com"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "adopt_existing", "true"),
+				),
 			},
 		},
 	})
@@ -117,6 +202,432 @@ resource "letscloud_ssh_key" "test" {
 `,
 				ExpectError: regexp.MustCompile(`Invalid SSH key format`),
 			},
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  label = "short-rsa-key"
+  key   = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQCoMbPNZfdjDk6aNDc9Xc4J1Bw1ajw1PgFkj7tvRRnQYZ9NS6zGYlUC8lZjekBo5hV0MagDyn92YgLz39avjwCp00Rje1lOls43mNrFPyfRxpLXRO4McjM9usRw0FLOwQo+gCWl8qBk/HcL3zUN14qfblcWNFDGfCuXfrEXQdqGiw== weak@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`RSA keys must be at least 2048 bits`),
+			},
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  label = "truncated-key"
+  key   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid SSH key format`),
+			},
+		},
+	})
+}
+
+func TestAccSSHKeyResource_KeyTypes(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "ecdsa" {
+  label = "ecdsa-key"
+  key   = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBBIWT5cAyi5VKwr98bwl+oSo7EiMBNsoB0OVdDIi401pb0jsmPoJZw26ejdwUjuiwtnZb/MZw9jw5GU6FbcbQvc= dave@example.com"
+}
+
+resource "letscloud_ssh_key" "fido" {
+  label = "fido-key"
+  key   = "sk-ssh-ed25519@openssh.com AAAAGnNrLXNzaC1lZDI1NTE5QG9wZW5zc2guY29tAAAAIAABAgMEBQYHCAkKCwwNDg8QERITFBUWFxgZGhscHR4fAAAABHNzaDo= yubikey@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("letscloud_ssh_key.ecdsa", "id"),
+					resource.TestCheckResourceAttrSet("letscloud_ssh_key.fido", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSHKeyResource_Rotate(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSSHKeyResourceRotateConfig("deploy-2025", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "key_type", "ssh-ed25519"),
+				),
+			},
+			// Rotating the key replaces it, creating the new key first
+			{
+				Config: providerConfig + testAccSSHKeyResourceRotateConfig("deploy-2026", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-2"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "label", "deploy-2026"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "fingerprint_sha256", "SHA256:ldTKCZegpm0mGwo05DjqGC8Ykm339oibbrII3ou07Os"),
+				),
+			},
+			// Provider-side attributes are updated in place
+			{
+				Config: providerConfig + testAccSSHKeyResourceRotateConfig("deploy-2026", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-2"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSHKeyResourceRotateConfig(label, key string, adoptExisting bool) string {
+	return fmt.Sprintf(`
+resource "letscloud_ssh_key" "test" {
+  label          = %[1]q
+  key            = %[2]q
+  adopt_existing = %[3]t
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, label, key, adoptExisting)
+}
+
+func TestAccSSHKeyResource_KeyEquivalence(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	const keyBlob = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The API stores the key without its comment, which is not drift
+			{
+				Config: providerConfig + testAccSSHKeyResourceKeyConfig(keyBlob+" alice@example.com\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "key", keyBlob+" alice@example.com\n"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "comment", "alice@example.com"),
+				),
+			},
+			// Editing the comment updates in place
+			{
+				Config: providerConfig + testAccSSHKeyResourceKeyConfig(keyBlob+" alice@laptop"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "comment", "alice@laptop"),
+				),
+			},
+			// A different key in the account is drift and replaces the SSH key
+			{
+				PreConfig: func() {
+					sshKey, err := mockClient.SSHKey("mock-ssh-key-1")
+					if err != nil {
+						t.Fatalf("failed to read SSH key: %s", err)
+					}
+					sshKey.PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ"
+				},
+				Config: providerConfig + testAccSSHKeyResourceKeyConfig(keyBlob+" alice@laptop"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "key", keyBlob+" alice@laptop"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "fingerprint_sha256", "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"),
+				),
+			},
+			// A key deleted outside Terraform is created again
+			{
+				PreConfig: func() {
+					if err := mockClient.DeleteSSHKey("mock-ssh-key-2"); err != nil {
+						t.Fatalf("failed to delete SSH key: %s", err)
+					}
+				},
+				Config: providerConfig + testAccSSHKeyResourceKeyConfig(keyBlob+" alice@laptop"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-3"),
+				),
+			},
+		},
+	})
+}
+
+// The refresh is exercised through the protocol server directly, as
+// acceptance tests need the Terraform CLI.
+func TestSSHKeyResource_ReadKeepsComment(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	server, err := providerserver.NewProtocol6WithError(provider.New("test")())()
+	if err != nil {
+		t.Fatalf("unable to create provider server: %s", err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unable to get provider schema: %s", err)
+	}
+
+	providerConfig := testDynamicValue(t, schemas.Provider, map[string]tftypes.Value{
+		"api_token": tftypes.NewValue(tftypes.String, "mock-token-for-testing"),
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("unable to configure provider: %v %v", err, configureResp.Diagnostics)
+	}
+
+	const keyBlob = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI"
+
+	// The API stores the key without its comment
+	sshKey, err := mockClient.CreateSSHKey(&domains.SSHKeyCreateRequest{Title: "comment-key", Key: keyBlob})
+	if err != nil {
+		t.Fatalf("unable to create SSH key: %s", err)
+	}
+
+	sshKeySchema := schemas.ResourceSchemas["letscloud_ssh_key"]
+	config := map[string]tftypes.Value{
+		"label": tftypes.NewValue(tftypes.String, "comment-key"),
+		"key":   tftypes.NewValue(tftypes.String, keyBlob+" alice@example.com"),
+	}
+	state := map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, sshKey.Slug),
+		"label":              config["label"],
+		"key":                config["key"],
+		"adopt_existing":     tftypes.NewValue(tftypes.Bool, false),
+		"fingerprint_sha256": tftypes.NewValue(tftypes.String, "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"),
+		"fingerprint_md5":    tftypes.NewValue(tftypes.String, ""),
+		"key_type":           tftypes.NewValue(tftypes.String, "ssh-ed25519"),
+		"key_bits":           tftypes.NewValue(tftypes.Number, 256),
+		"comment":            tftypes.NewValue(tftypes.String, "alice@example.com"),
+	}
+
+	readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     "letscloud_ssh_key",
+		CurrentState: testDynamicValue(t, sshKeySchema, state),
+	})
+	if err != nil || len(readResp.Diagnostics) > 0 {
+		t.Fatalf("unable to read resource: %v %v", err, readResp.Diagnostics)
+	}
+
+	refreshed, err := readResp.NewState.Unmarshal(sshKeySchema.ValueType())
+	if err != nil {
+		t.Fatalf("unable to decode state: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := refreshed.As(&attributes); err != nil {
+		t.Fatalf("unable to decode state: %s", err)
+	}
+
+	var key, comment string
+	for name, target := range map[string]*string{"key": &key, "comment": &comment} {
+		if err := attributes[name].As(target); err != nil {
+			t.Fatalf("unable to decode %s: %s", name, err)
+		}
+	}
+	if key != keyBlob+" alice@example.com" {
+		t.Errorf("expected the key in state to be kept, got %q", key)
+	}
+	if comment != "alice@example.com" {
+		t.Errorf("expected comment alice@example.com, got %q", comment)
+	}
+
+	// The unchanged configuration plans no changes
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "letscloud_ssh_key",
+		PriorState:       readResp.NewState,
+		ProposedNewState: readResp.NewState,
+		Config:           testDynamicValue(t, sshKeySchema, config),
+		PriorPrivate:     readResp.Private,
+	})
+	if err != nil || len(planResp.Diagnostics) > 0 {
+		t.Fatalf("unable to plan resource change: %v %v", err, planResp.Diagnostics)
+	}
+
+	planned, err := planResp.PlannedState.Unmarshal(sshKeySchema.ValueType())
+	if err != nil {
+		t.Fatalf("unable to decode plan: %s", err)
+	}
+	if diffs, _ := refreshed.Diff(planned); len(diffs) > 0 {
+		t.Errorf("expected no changes, got %v", diffs)
+	}
+	if len(planResp.RequiresReplace) > 0 {
+		t.Errorf("expected no replacement, got %v", planResp.RequiresReplace)
+	}
+
+	// A key deleted outside Terraform is removed from state
+	if err := mockClient.DeleteSSHKey(sshKey.Slug); err != nil {
+		t.Fatalf("unable to delete SSH key: %s", err)
+	}
+
+	readResp, err = server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     "letscloud_ssh_key",
+		CurrentState: testDynamicValue(t, sshKeySchema, state),
+	})
+	if err != nil || len(readResp.Diagnostics) > 0 {
+		t.Fatalf("unable to read resource: %v %v", err, readResp.Diagnostics)
+	}
+
+	removed, err := readResp.NewState.Unmarshal(sshKeySchema.ValueType())
+	if err != nil {
+		t.Fatalf("unable to decode state: %s", err)
+	}
+	if !removed.IsNull() {
+		t.Errorf("expected the resource to be removed, got %v", removed)
+	}
+}
+
+func testAccSSHKeyResourceKeyConfig(key string) string {
+	return fmt.Sprintf(`
+resource "letscloud_ssh_key" "test" {
+  label = "equivalence-key"
+  key   = %q
+}
+`, key)
+}
+
+func TestAccSSHKeyResource_LabelPrefix(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSSHKeyResourceLabelPrefixConfig("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("letscloud_ssh_key.test", "label", regexp.MustCompile(`^deploy-\d{18}$`)),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "label_prefix", "deploy-"),
+				),
+			},
+			// Rotating the key keeps the prefix and gets a new unique label
+			{
+				Config: providerConfig + testAccSSHKeyResourceLabelPrefixConfig("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("letscloud_ssh_key.test", "label", regexp.MustCompile(`^deploy-\d{18}$`)),
+					resource.TestCheckResourceAttr("letscloud_ssh_key.test", "id", "mock-ssh-key-2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key" "test" {
+  key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`One of label or label_prefix must be set`),
+			},
+		},
+	})
+}
+
+func testAccSSHKeyResourceLabelPrefixConfig(key string) string {
+	return fmt.Sprintf(`
+resource "letscloud_ssh_key" "test" {
+  label_prefix = "deploy-"
+  key          = %q
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, key)
+}
+
+func TestAccSSHKeySetResource(t *testing.T) {
+	mockClient := provider.NewLetsCloudClientMock()
+	provider.MockLetsCloudClient = mockClient
+
+	alice := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBcqo6St4O1Pnco+z8es++hJ6N6Evrx60MeB2K2JYwsI alice@example.com"
+	ci := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ ci@example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSSHKeySetResourceConfig(alice),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.%", "1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.alice@example.com", "mock-ssh-key-1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "fingerprints.alice@example.com", "SHA256:9VzoTZC5pqqNS5/QZY5VKrWNwGeXk6APxxV2spH9F+A"),
+				),
+			},
+			// Adding a key leaves the existing one untouched
+			{
+				Config: providerConfig + testAccSSHKeySetResourceConfig(alice+"\n"+ci),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("letscloud_ssh_key_set.team", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.%", "2"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.alice@example.com", "mock-ssh-key-1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.ci@example.com", "mock-ssh-key-2"),
+				),
+			},
+			// Removing a key deletes only that key
+			{
+				Config: providerConfig + testAccSSHKeySetResourceConfig(ci),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.%", "1"),
+					resource.TestCheckResourceAttr("letscloud_ssh_key_set.team", "ssh_key_ids.ci@example.com", "mock-ssh-key-2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "letscloud_ssh_key_set" "team" {
+  authorized_keys = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFfBgwgAPnt3xr/ehVsFiM4I+LTG0fDRPC1ONtR8DYoZ"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing SSH Key Label"),
+			},
 		},
 	})
 }
+
+func testAccSSHKeySetResourceConfig(authorizedKeys string) string {
+	return fmt.Sprintf(`
+resource "letscloud_ssh_key_set" "team" {
+  authorized_keys = <<-EOT
+%s
+  EOT
+}
+`, authorizedKeys)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the validator satisfies the framework interface.
+var _ validator.String = publicKeyValidator{}
+
+// publicKeyValidator checks that a string is a single SSH public key of a
+// type LetsCloud accepts.
+type publicKeyValidator struct{}
+
+func (v publicKeyValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a single OpenSSH public key of type %s, with RSA keys of at least %d bits",
+		strings.Join(supportedKeyTypes, ", "), minRSAKeyBits)
+}
+
+func (v publicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v publicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSupportedKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SSH key format",
+			fmt.Sprintf("The value is not a valid SSH public key: %s.", err),
+		)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sshkey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPublicKeyValidator(t *testing.T) {
+	tests := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"valid key": {
+			value: types.StringValue(testKeyEd25519),
+		},
+		"trailing newline": {
+			value: types.StringValue(testKeyEd25519 + "\n"),
+		},
+		"leading junk": {
+			value:       types.StringValue("garbage\n" + testKeyEd25519),
+			expectError: true,
+		},
+		"trailing key": {
+			value:       types.StringValue(testKeyEd25519 + "\n" + testKeyRSA),
+			expectError: true,
+		},
+		"unsupported type": {
+			value:       types.StringValue(testKeyDSA),
+			expectError: true,
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("key"),
+				ConfigValue: test.value,
+			}
+			resp := &validator.StringResponse{}
+
+			publicKeyValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}